@@ -0,0 +1,111 @@
+// Package aesgcm provides a reference protoprivacy.Crypter that encrypts data using AES-256-GCM with a random key per
+// data subject.
+//
+// Each ciphertext contains an identifier of the key it was encrypted with, so that data encrypted with a key that has
+// since been deleted and replaced is treated as shredded rather than as corrupt. The data subject id is used as
+// additional authenticated data.
+package aesgcm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Boostport/protoprivacy"
+	"github.com/Boostport/protoprivacy/internal/aead"
+	"github.com/Boostport/protoprivacy/keycache"
+	"github.com/Boostport/protoprivacy/keystore"
+)
+
+// Crypter encrypts and decrypts data using AES-256-GCM with keys from a keystore.KeyStore. It implements
+// protoprivacy.Crypter.
+type Crypter struct {
+	store keystore.KeyStore
+}
+
+// New returns a Crypter that stores the keys of data subjects in store. Keys are created when data is first encrypted
+// for a data subject. Shredding a data subject deletes its key from the store.
+func New(store keystore.KeyStore) *Crypter {
+	return &Crypter{
+		store: store,
+	}
+}
+
+func (c *Crypter) Encrypt(ctx context.Context, dataSubjectID string, cleartext []byte) ([]byte, error) {
+	handle, err := c.KeyHandle(ctx, dataSubjectID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return handle.Encrypt(ctx, cleartext)
+}
+
+func (c *Crypter) Decrypt(ctx context.Context, dataSubjectID string, ciphertext []byte) ([]byte, error) {
+	handle, err := c.KeyHandle(ctx, dataSubjectID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return handle.Decrypt(ctx, ciphertext)
+}
+
+// KeyHandle returns a key handle for the key of the data subject id, so the Crypter can be wrapped by a
+// keycache.Crypter. It implements keycache.KeyHandleProvider.
+func (c *Crypter) KeyHandle(ctx context.Context, dataSubjectID string, create bool) (keycache.KeyHandle, error) {
+	if create {
+		key, err := keystore.GetOrCreate(ctx, c.store, dataSubjectID, aead.NewKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return aead.NewHandle(key, dataSubjectID), nil
+	}
+
+	key, err := c.store.Get(ctx, dataSubjectID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting key: %w", err)
+	}
+
+	if key == nil {
+		return nil, protoprivacy.ErrKeyShredded
+	}
+
+	return aead.NewHandle(key, dataSubjectID), nil
+}
+
+// Shred deletes the key of the data subject id, shredding its data. It implements protoprivacy.Shredder.
+func (c *Crypter) Shred(ctx context.Context, dataSubjectID string) error {
+	if err := c.store.Delete(ctx, dataSubjectID); err != nil {
+		return fmt.Errorf("error deleting key: %w", err)
+	}
+
+	return nil
+}
+
+// ShredPrefix deletes the keys of all data subject ids starting with the prefix.
+func (c *Crypter) ShredPrefix(ctx context.Context, prefix string) error {
+	if err := c.store.DeletePrefix(ctx, prefix); err != nil {
+		return fmt.Errorf("error deleting keys: %w", err)
+	}
+
+	return nil
+}
+
+// ShredSuffix deletes the keys of all data subject ids ending with the suffix. The key store must implement
+// keystore.SuffixDeleter.
+func (c *Crypter) ShredSuffix(ctx context.Context, suffix string) error {
+	if err := keystore.DeleteSuffix(ctx, c.store, suffix); err != nil {
+		return fmt.Errorf("error deleting keys: %w", err)
+	}
+
+	return nil
+}
+
+// IsShredded reports whether the data subject id does not have a key.
+func (c *Crypter) IsShredded(ctx context.Context, dataSubjectID string) (bool, error) {
+	key, err := c.store.Get(ctx, dataSubjectID)
+	if err != nil {
+		return false, fmt.Errorf("error getting key: %w", err)
+	}
+
+	return key == nil, nil
+}
@@ -0,0 +1,181 @@
+package aesgcm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Boostport/protoprivacy"
+	"github.com/Boostport/protoprivacy/crypttest"
+	"github.com/Boostport/protoprivacy/frame"
+	"github.com/Boostport/protoprivacy/internal/aead"
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"github.com/Boostport/protoprivacy/keystore"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCrypter(t *testing.T) {
+	ctx := context.Background()
+	c := New(keystore.NewMemory())
+
+	first, err := c.Encrypt(ctx, "user:123", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	second, err := c.Encrypt(ctx, "user:123", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if bytes.Equal(first, second) {
+		t.Error("Expected distinct ciphertexts for identical cleartexts")
+	}
+
+	cleartext, err := c.Decrypt(ctx, "user:123", first)
+	if err != nil {
+		t.Fatalf("Error decrypting: %v", err)
+	}
+
+	if !bytes.Equal(cleartext, []byte("test")) {
+		t.Errorf("Expected cleartext %q, got %q", "test", cleartext)
+	}
+
+	tampered := bytes.Clone(first)
+	tampered[len(tampered)-1] ^= 1
+
+	if _, err := c.Decrypt(ctx, "user:123", tampered); !errors.Is(err, protoprivacy.ErrCiphertextCorrupt) {
+		t.Errorf("Expected ErrCiphertextCorrupt decrypting tampered ciphertext, got %v", err)
+	}
+
+	if _, err := c.Decrypt(ctx, "user:123", first[:aead.HeaderSize-1]); !errors.Is(err, protoprivacy.ErrCiphertextCorrupt) {
+		t.Errorf("Expected ErrCiphertextCorrupt decrypting truncated ciphertext, got %v", err)
+	}
+
+	empty, err := c.Encrypt(ctx, "user:123", []byte{})
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	cleartext, err = c.Decrypt(ctx, "user:123", empty)
+	if err != nil {
+		t.Fatalf("Error decrypting: %v", err)
+	}
+
+	if cleartext == nil || len(cleartext) != 0 {
+		t.Errorf("Expected empty non-nil cleartext, got %v", cleartext)
+	}
+}
+
+func TestCrypterDeletedKey(t *testing.T) {
+	ctx := context.Background()
+	store := keystore.NewMemory()
+	c := New(store)
+
+	ciphertext, err := c.Encrypt(ctx, "user:123", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if err := store.Delete(ctx, "user:123"); err != nil {
+		t.Fatalf("Error deleting key: %v", err)
+	}
+
+	if _, err := c.Decrypt(ctx, "user:123", ciphertext); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected ErrKeyShredded for deleted key, got %v", err)
+	}
+
+	// Encrypting new data creates a new key, which must not make the old data look corrupt
+	if _, err := c.Encrypt(ctx, "user:123", []byte("test")); err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if _, err := c.Decrypt(ctx, "user:123", ciphertext); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected ErrKeyShredded for replaced key, got %v", err)
+	}
+}
+
+func TestCrypterShred(t *testing.T) {
+	ctx := context.Background()
+	c := New(keystore.NewMemory())
+
+	ciphertext, err := c.Encrypt(ctx, "user:123", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if shredded, err := c.IsShredded(ctx, "user:123"); err != nil || shredded {
+		t.Errorf("Expected data subject not to be shredded, got %t, %v", shredded, err)
+	}
+
+	if err := c.ShredPrefix(ctx, "user:"); err != nil {
+		t.Fatalf("Error shredding prefix: %v", err)
+	}
+
+	if shredded, err := c.IsShredded(ctx, "user:123"); err != nil || !shredded {
+		t.Errorf("Expected data subject to be shredded, got %t, %v", shredded, err)
+	}
+
+	if _, err := c.Decrypt(ctx, "user:123", ciphertext); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected ErrKeyShredded for shredded data subject, got %v", err)
+	}
+}
+
+func TestCrypterWithPrivacy(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := keystore.NewFile(t.TempDir())
+	if err != nil {
+		t.Fatalf("Error creating file key store: %v", err)
+	}
+
+	p := protoprivacy.New(New(store))
+
+	msg := testprotos.TestFallbackTypes_builder{
+		Id:     proto.String("123"),
+		Data14: proto.String("secret"),
+	}.Build()
+
+	encrypted, err := p.Encrypt(ctx, msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	info, err := frame.Inspect(encrypted)
+	if err != nil {
+		t.Fatalf("Error inspecting envelope: %v", err)
+	}
+
+	if info.Algorithm != frame.AlgorithmAES256GCM {
+		t.Errorf("Expected algorithm %s, got %s", frame.AlgorithmAES256GCM, info.Algorithm)
+	}
+
+	decrypted, err := p.Decrypt(ctx, encrypted)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if !proto.Equal(decrypted, msg) {
+		t.Errorf("Decrypted message does not match original message, expected %v, got %v", msg, decrypted)
+	}
+
+	if err := p.Shred(ctx, encrypted); err != nil {
+		t.Fatalf("Error shredding data subject: %v", err)
+	}
+
+	decrypted, err = p.Decrypt(ctx, encrypted)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if got := decrypted.(*testprotos.TestFallbackTypes).GetData14(); got != "test" {
+		t.Errorf("Expected fallback value %q for shredded data subject, got %q", "test", got)
+	}
+}
+
+func TestConformance(t *testing.T) {
+	crypttest.TestCrypter(t, func(t *testing.T) protoprivacy.Crypter {
+		return New(keystore.NewMemory())
+	})
+}
@@ -0,0 +1,75 @@
+package protoprivacy
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const defaultBlindIndexLength = 8
+
+// BlindIndex computes the blind index of term for the field with the given full name (for example,
+// "my.package.UserCreated.email_address"), allowing envelopes to be looked up by personal data. The term is normalized
+// in the same way as the field's value during encryption.
+func (p *Privacy) BlindIndex(field protoreflect.FullName, term string) (string, error) {
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(field)
+	if err != nil {
+		return "", fmt.Errorf("error finding field %s: %w", field, err)
+	}
+
+	fd, ok := descriptor.(protoreflect.FieldDescriptor)
+	if !ok {
+		return "", fmt.Errorf("%s is not a field", field)
+	}
+
+	privacyField, _ := proto.GetExtension(fd.Options(), privacy.E_Field).(*privacy.PrivacyFieldOptions)
+
+	blindIndex := privacyField.GetPersonalData().GetBlindIndex()
+	if blindIndex == nil {
+		return "", fmt.Errorf("field %s does not have a blind index", field)
+	}
+
+	return computeBlindIndex(blindIndex, p.blindIndexKey, term)
+}
+
+// computeBlindIndex returns the hex encoded, truncated HMAC-SHA256 of the normalized value.
+func computeBlindIndex(blindIndex *privacy.PrivacyFieldOptions_BlindIndex, key []byte, value string) (string, error) {
+	if len(key) == 0 {
+		return "", errors.New("no blind index key configured")
+	}
+
+	length := defaultBlindIndexLength
+	if blindIndex.HasLength() {
+		length = min(int(blindIndex.GetLength()), sha256.Size)
+	}
+
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(normalizeBlindIndexValue(blindIndex.GetNormalization(), value)))
+
+	return hex.EncodeToString(h.Sum(nil)[:length]), nil
+}
+
+func normalizeBlindIndexValue(normalization privacy.PrivacyFieldOptions_BlindIndex_Normalization, value string) string {
+	switch normalization {
+	case privacy.PrivacyFieldOptions_BlindIndex_NORMALIZATION_NONE:
+		return value
+	case privacy.PrivacyFieldOptions_BlindIndex_NORMALIZATION_DIGITS:
+		return strings.Map(func(r rune) rune {
+			if unicode.IsDigit(r) {
+				return r
+			}
+			return -1
+		}, value)
+	}
+
+	return strings.ToLower(strings.TrimSpace(value))
+}
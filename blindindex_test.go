@@ -0,0 +1,151 @@
+package protoprivacy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestBlindIndex(t *testing.T) {
+	p := New(fakeCrypter{}, WithBlindIndexKey([]byte("key")))
+
+	msg := testprotos.TestBlindIndex_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("John@Example.com"),
+		Data2: proto.String("+61 (3) 9000 0000"),
+	}.Build()
+
+	envelope, err := p.Encrypt(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	redacted, err := envelope.(*privacy.Envelope).GetMessage().UnmarshalNew()
+	if err != nil {
+		t.Fatalf("Error unmarshaling redacted message: %v", err)
+	}
+
+	emailIndex, err := p.BlindIndex("boostport.privacy.testing.TestBlindIndex.data1", "  john@example.COM ")
+	if err != nil {
+		t.Fatalf("Error computing blind index: %v", err)
+	}
+
+	if actual := redacted.(*testprotos.TestBlindIndex).GetData1Index(); actual != emailIndex || len(actual) != 16 {
+		t.Errorf("Expected blind index %q in target field, got %q", emailIndex, actual)
+	}
+
+	phoneIndex, err := p.BlindIndex("boostport.privacy.testing.TestBlindIndex.data2", "61390000000")
+	if err != nil {
+		t.Fatalf("Error computing blind index: %v", err)
+	}
+
+	if actual := envelope.(*privacy.Envelope).GetBlindIndexes()["boostport.privacy.testing.TestBlindIndex.data2"]; actual != phoneIndex || len(actual) != 8 {
+		t.Errorf("Expected blind index %q in envelope, got %q", phoneIndex, actual)
+	}
+
+	shredded, err := New(fakeDeletedDataSubjectCrypter{}).Decrypt(context.Background(), envelope)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if shredded.(*testprotos.TestBlindIndex).HasData1Index() {
+		t.Error("Blind index target field should be cleared after the data subject has been deleted")
+	}
+}
+
+func TestBlindIndexEmptyValue(t *testing.T) {
+	p := New(fakeCrypter{}, WithBlindIndexKey([]byte("key")))
+
+	msg := testprotos.TestBlindIndex_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("  "),
+		Data2: proto.String("none"),
+	}.Build()
+
+	envelope, err := p.Encrypt(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	redacted, err := envelope.(*privacy.Envelope).GetMessage().UnmarshalNew()
+	if err != nil {
+		t.Fatalf("Error unmarshaling redacted message: %v", err)
+	}
+
+	if redacted.(*testprotos.TestBlindIndex).HasData1Index() {
+		t.Errorf("Expected no blind index in target field for empty value, got %q", redacted.(*testprotos.TestBlindIndex).GetData1Index())
+	}
+
+	if indexes := envelope.(*privacy.Envelope).GetBlindIndexes(); len(indexes) != 0 {
+		t.Errorf("Expected no blind indexes in envelope for empty value, got %v", indexes)
+	}
+}
+
+func TestBlindIndexRepeated(t *testing.T) {
+	p := New(fakeCrypter{}, WithBlindIndexKey([]byte("key")))
+
+	msg := testprotos.TestBlindIndexRepeated_builder{
+		Id: proto.String("123"),
+		Items: []*testprotos.TestBlindIndexRepeatedItem{
+			testprotos.TestBlindIndexRepeatedItem_builder{Data1: proto.String("john@example.com")}.Build(),
+			testprotos.TestBlindIndexRepeatedItem_builder{Data1: proto.String("jane@example.com")}.Build(),
+		},
+	}.Build()
+
+	envelope, err := p.Encrypt(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	redacted, err := envelope.(*privacy.Envelope).GetMessage().UnmarshalNew()
+	if err != nil {
+		t.Fatalf("Error unmarshaling redacted message: %v", err)
+	}
+
+	items := redacted.(*testprotos.TestBlindIndexRepeated).GetItems()
+
+	for i, term := range []string{"john@example.com", "jane@example.com"} {
+		index, err := p.BlindIndex("boostport.privacy.testing.TestBlindIndexRepeatedItem.data1", term)
+		if err != nil {
+			t.Fatalf("Error computing blind index: %v", err)
+		}
+
+		if actual := items[i].GetData1Index(); actual != index {
+			t.Errorf("Expected blind index %q in target field of element %d, got %q", index, i, actual)
+		}
+	}
+}
+
+func TestBlindIndexErrors(t *testing.T) {
+	for _, tt := range []struct {
+		explanation string
+		privacy     *Privacy
+		field       string
+	}{
+		{
+			explanation: "No key",
+			privacy:     New(fakeCrypter{}),
+			field:       "boostport.privacy.testing.TestBlindIndex.data1",
+		},
+		{
+			explanation: "Unknown field",
+			privacy:     New(fakeCrypter{}, WithBlindIndexKey([]byte("key"))),
+			field:       "boostport.privacy.testing.TestBlindIndex.unknown",
+		},
+		{
+			explanation: "Field without blind index",
+			privacy:     New(fakeCrypter{}, WithBlindIndexKey([]byte("key"))),
+			field:       "boostport.privacy.testing.TestBlindIndex.data1_index",
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			if _, err := tt.privacy.BlindIndex(protoreflect.FullName(tt.field), "test"); err == nil {
+				t.Error("Expected error computing blind index")
+			}
+		})
+	}
+}
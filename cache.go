@@ -4,6 +4,8 @@ import "google.golang.org/protobuf/reflect/protoreflect"
 
 type message struct {
 	hasPrivacyFields bool
+	conditions       conditions
+	retention        *retention
 	err              error
 }
 
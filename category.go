@@ -0,0 +1,96 @@
+package protoprivacy
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Categories of personal data that can be set on personal data fields using the category field option. Fields without
+// a category have the CategoryUnspecified category. Fields with a custom category have the category set using the
+// custom_category field option.
+const (
+	CategoryUnspecified = "unspecified"
+	CategoryContact     = "contact"
+	CategoryIdentity    = "identity"
+	CategoryDemographic = "demographic"
+	CategoryLocation    = "location"
+	CategoryFinancial   = "financial"
+	CategoryHealth      = "health"
+	CategoryBiometric   = "biometric"
+	CategoryGenetic     = "genetic"
+)
+
+type authorizedCategoriesKey struct{}
+
+// WithAuthorizedCategories returns a context that restricts Decrypt to the given categories of personal data. Personal
+// data fields in other categories are cleared or set to their fallback values, even if the data subject's key is
+// available. If the context does not contain authorized categories, all categories are decrypted.
+func WithAuthorizedCategories(ctx context.Context, categories ...string) context.Context {
+	authorized := make(map[string]struct{}, len(categories))
+	for _, category := range categories {
+		authorized[category] = struct{}{}
+	}
+
+	return context.WithValue(ctx, authorizedCategoriesKey{}, authorized)
+}
+
+// fieldFilter reports whether a personal data field should be selected.
+type fieldFilter func(fd protoreflect.FieldDescriptor, personalData *privacy.PrivacyFieldOptions_PersonalData) bool
+
+// allFieldsFilter selects every personal data field.
+func allFieldsFilter(protoreflect.FieldDescriptor, *privacy.PrivacyFieldOptions_PersonalData) bool {
+	return true
+}
+
+// anyFieldFilter returns a filter selecting the fields selected by at least one of the filters, or nil if all filters
+// are nil. All filters are evaluated for each field, so filters that record the fields they select see every field.
+func anyFieldFilter(filters ...fieldFilter) fieldFilter {
+	var nonNil []fieldFilter
+
+	for _, filter := range filters {
+		if filter != nil {
+			nonNil = append(nonNil, filter)
+		}
+	}
+
+	if len(nonNil) == 0 {
+		return nil
+	}
+
+	return func(fd protoreflect.FieldDescriptor, personalData *privacy.PrivacyFieldOptions_PersonalData) bool {
+		selected := false
+
+		for _, filter := range nonNil {
+			if filter(fd, personalData) {
+				selected = true
+			}
+		}
+
+		return selected
+	}
+}
+
+// unauthorizedCategoryFilter returns a filter selecting the personal data fields in categories the caller is not
+// authorized to decrypt, or nil if the caller is authorized to decrypt all categories.
+func unauthorizedCategoryFilter(ctx context.Context) fieldFilter {
+	authorized, ok := ctx.Value(authorizedCategoriesKey{}).(map[string]struct{})
+	if !ok {
+		return nil
+	}
+
+	return func(_ protoreflect.FieldDescriptor, personalData *privacy.PrivacyFieldOptions_PersonalData) bool {
+		_, ok := authorized[personalDataCategory(personalData)]
+		return !ok
+	}
+}
+
+func personalDataCategory(personalData *privacy.PrivacyFieldOptions_PersonalData) string {
+	if personalData.HasCustomCategory() {
+		return personalData.GetCustomCategory()
+	}
+
+	return strings.ToLower(strings.TrimPrefix(personalData.GetCategory().String(), "DATA_CATEGORY_"))
+}
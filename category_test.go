@@ -0,0 +1,76 @@
+package protoprivacy
+
+import (
+	"context"
+	"testing"
+
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDecryptWithAuthorizedCategories(t *testing.T) {
+	msg := testprotos.TestCategories_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("john@example.com"),
+		Data2: proto.String("diagnosis"),
+		Data3: proto.String("newsletter"),
+		Data4: proto.String("uncategorized"),
+	}.Build()
+
+	for _, tt := range []struct {
+		explanation string
+		ctx         context.Context
+		expected    proto.Message
+	}{
+		{
+			explanation: "No restriction",
+			ctx:         context.Background(),
+			expected:    msg,
+		},
+		{
+			explanation: "Contact and custom category",
+			ctx:         WithAuthorizedCategories(context.Background(), CategoryContact, "marketing"),
+			expected: testprotos.TestCategories_builder{
+				Id:    proto.String("123"),
+				Data1: proto.String("john@example.com"),
+				Data2: proto.String("hidden"),
+				Data3: proto.String("newsletter"),
+			}.Build(),
+		},
+		{
+			explanation: "Health and uncategorized",
+			ctx:         WithAuthorizedCategories(context.Background(), CategoryHealth, CategoryUnspecified),
+			expected: testprotos.TestCategories_builder{
+				Id:    proto.String("123"),
+				Data2: proto.String("diagnosis"),
+				Data4: proto.String("uncategorized"),
+			}.Build(),
+		},
+		{
+			explanation: "No categories",
+			ctx:         WithAuthorizedCategories(context.Background()),
+			expected: testprotos.TestCategories_builder{
+				Id:    proto.String("123"),
+				Data2: proto.String("hidden"),
+			}.Build(),
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			p := New(fakeCrypter{})
+
+			envelope, err := p.Encrypt(context.Background(), msg)
+			if err != nil {
+				t.Fatalf("Error encrypting message: %v", err)
+			}
+
+			decrypted, err := p.Decrypt(tt.ctx, envelope)
+			if err != nil {
+				t.Fatalf("Error decrypting message: %v", err)
+			}
+
+			if !proto.Equal(tt.expected, decrypted) {
+				t.Errorf("Decrypted message does not match expected message: %v", decrypted)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package protoprivacy
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// defaultMaxDecompressedSize is the default maximum size of decompressed data, which guards against decompression
+// bombs.
+const defaultMaxDecompressedSize = 64 << 20
+
+const gzipCompressorName = "gzip"
+
+// Compressor compresses marshaled messages before they are encrypted. Implement it to use compression algorithms other
+// than gzip, such as zstd.
+type Compressor interface {
+	// Name identifies the compression algorithm in envelopes, so that the data can be decompressed by the matching
+	// compressor. It must not be empty and must not change.
+	Name() string
+
+	// NewWriter returns a writer that compresses data written to it into w. The data is flushed when it is closed.
+	NewWriter(w io.Writer) (io.WriteCloser, error)
+
+	// NewReader returns a reader that decompresses data read from r.
+	NewReader(r io.Reader) (io.ReadCloser, error)
+}
+
+// WithCompression compresses marshaled messages of at least threshold bytes using compressor before encrypting them.
+// The compressor is recorded in the envelope, and Decrypt decompresses the data transparently. Data that does not
+// become smaller when compressed is stored uncompressed. If compressor is nil or its name is empty, the methods of the
+// Privacy instance that process messages return an error.
+//
+// The size of compressed data depends on its content, so the size of the ciphertext can reveal personal data if an
+// attacker controls part of the message and observes the ciphertext sizes, as in the CRIME and BREACH attacks. Do not
+// compress messages in which attacker-controlled fields are encrypted along with secret personal data fields.
+func WithCompression(compressor Compressor, threshold int) Option {
+	return func(p *Privacy) {
+		if err := validateCompressor(compressor); err != nil {
+			p.configErr = errors.Join(p.configErr, err)
+			return
+		}
+
+		p.compressor = compressor
+		p.compressionThreshold = threshold
+		p.decompressors[compressor.Name()] = compressor
+	}
+}
+
+// WithDecompressors registers compressors that are only used to decompress data, for example after switching
+// WithCompression to a different compressor. If a compressor is nil or its name is empty, the methods of the Privacy
+// instance that process messages return an error.
+func WithDecompressors(compressors ...Compressor) Option {
+	return func(p *Privacy) {
+		for _, compressor := range compressors {
+			if err := validateCompressor(compressor); err != nil {
+				p.configErr = errors.Join(p.configErr, err)
+				continue
+			}
+
+			p.decompressors[compressor.Name()] = compressor
+		}
+	}
+}
+
+func validateCompressor(compressor Compressor) error {
+	if compressor == nil {
+		return errors.New("compressor must not be nil")
+	}
+
+	if compressor.Name() == "" {
+		return errors.New("compressor name must not be empty")
+	}
+
+	return nil
+}
+
+// WithMaxDecompressedSize sets the maximum size in bytes of decompressed data, guarding against decompression bombs.
+// Decrypt returns an error for data that exceeds it. The default is 64 MiB.
+func WithMaxDecompressedSize(size int64) Option {
+	return func(p *Privacy) {
+		p.maxDecompressedSize = size
+	}
+}
+
+type gzipCompressor struct {
+	level int
+}
+
+// NewGzipCompressor returns a Compressor that compresses data using gzip with the given compression level, such as
+// gzip.DefaultCompression. Data compressed using gzip can always be decompressed, without registering the compressor.
+func NewGzipCompressor(level int) Compressor {
+	return gzipCompressor{level: level}
+}
+
+func (c gzipCompressor) Name() string {
+	return gzipCompressorName
+}
+
+func (c gzipCompressor) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return gzip.NewWriterLevel(w, c.level)
+}
+
+func (c gzipCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return gzip.NewReader(r)
+}
+
+// compress compresses the marshaled message if it is at least as large as the compression threshold. The name of the
+// compressor is returned if the data was compressed, otherwise the data is returned unchanged along with an empty name.
+func (p *Privacy) compress(data []byte) ([]byte, string, error) {
+	if p.compressor == nil || len(data) < p.compressionThreshold {
+		return data, "", nil
+	}
+
+	name := p.compressor.Name()
+
+	var compressed bytes.Buffer
+
+	w, err := p.compressor.NewWriter(&compressed)
+	if err != nil {
+		return nil, "", fmt.Errorf("error creating %s writer: %w", name, err)
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return nil, "", fmt.Errorf("error compressing using %s: %w", name, err)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, "", fmt.Errorf("error compressing using %s: %w", name, err)
+	}
+
+	// Incompressible data is stored uncompressed, so decrypting it does not need to decompress it
+	if compressed.Len() >= len(data) {
+		return data, "", nil
+	}
+
+	return compressed.Bytes(), name, nil
+}
+
+// decompress decompresses data compressed by the compressor with the given name. An error is returned if the
+// decompressed data is larger than the maximum decompressed size.
+func (p *Privacy) decompress(data []byte, name string) ([]byte, error) {
+	if name == "" {
+		return data, nil
+	}
+
+	compressor, ok := p.decompressors[name]
+	if !ok {
+		return nil, fmt.Errorf("no compressor registered for %s", name)
+	}
+
+	r, err := compressor.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s reader: %w", name, err)
+	}
+	defer r.Close()
+
+	maxSize := p.maxDecompressedSize
+	if maxSize <= 0 {
+		maxSize = defaultMaxDecompressedSize
+	}
+
+	decompressed, err := io.ReadAll(io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing using %s: %w", name, err)
+	}
+
+	if int64(len(decompressed)) > maxSize {
+		return nil, fmt.Errorf("decompressed data exceeds maximum size of %d bytes", maxSize)
+	}
+
+	return decompressed, nil
+}
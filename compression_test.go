@@ -0,0 +1,184 @@
+package protoprivacy
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+)
+
+// namedCompressor is a gzip compressor with a different name, standing in for compressors such as zstd.
+type namedCompressor struct {
+	name string
+}
+
+func (c namedCompressor) Name() string {
+	return c.name
+}
+
+func (c namedCompressor) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return gzip.NewWriter(w), nil
+}
+
+func (c namedCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return gzip.NewReader(r)
+}
+
+func TestCompression(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		explanation string
+		data        string
+		threshold   int
+		compression string
+	}{
+		{
+			explanation: "Above threshold",
+			data:        strings.Repeat("test", 250),
+			threshold:   100,
+			compression: "gzip",
+		},
+		{
+			explanation: "Below threshold",
+			data:        strings.Repeat("test", 250),
+			threshold:   10000,
+		},
+		{
+			explanation: "Incompressible",
+			data:        "test",
+			threshold:   0,
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			p := New(fakeCrypter{}, WithCompression(NewGzipCompressor(gzip.BestCompression), tt.threshold))
+
+			msg := testprotos.TestMessage_builder{
+				Id:    proto.String("123"),
+				Data1: proto.String(tt.data),
+			}.Build()
+
+			encrypted, err := p.Encrypt(ctx, msg)
+			if err != nil {
+				t.Fatalf("Error encrypting message: %v", err)
+			}
+
+			if compression := encrypted.(*privacy.Envelope).GetCompression(); compression != tt.compression {
+				t.Errorf("Expected compression %q, got %q", tt.compression, compression)
+			}
+
+			// Compressed data can be decrypted without configuring compression
+			decrypted, err := New(fakeCrypter{}).Decrypt(ctx, encrypted)
+			if err != nil {
+				t.Fatalf("Error decrypting message: %v", err)
+			}
+
+			if !proto.Equal(decrypted, msg) {
+				t.Errorf("Decrypted message does not match original message, expected %v, got %v", msg, decrypted)
+			}
+		})
+	}
+}
+
+func TestCompressionCustomCompressor(t *testing.T) {
+	ctx := context.Background()
+	compressor := namedCompressor{name: "custom"}
+
+	msg := testprotos.TestMessage_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String(strings.Repeat("test", 250)),
+	}.Build()
+
+	encrypted, err := New(fakeCrypter{}, WithCompression(compressor, 0)).Encrypt(ctx, msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	if compression := encrypted.(*privacy.Envelope).GetCompression(); compression != "custom" {
+		t.Errorf("Expected compression %q, got %q", "custom", compression)
+	}
+
+	if _, err := New(fakeCrypter{}).Decrypt(ctx, encrypted); err == nil {
+		t.Error("Expected error decrypting message compressed by unregistered compressor")
+	}
+
+	decrypted, err := New(fakeCrypter{}, WithDecompressors(compressor)).Decrypt(ctx, encrypted)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if !proto.Equal(decrypted, msg) {
+		t.Errorf("Decrypted message does not match original message, expected %v, got %v", msg, decrypted)
+	}
+}
+
+func TestCompressionMaxDecompressedSize(t *testing.T) {
+	ctx := context.Background()
+
+	msg := testprotos.TestMessage_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String(strings.Repeat("test", 250)),
+	}.Build()
+
+	encrypted, err := New(fakeCrypter{}, WithCompression(NewGzipCompressor(gzip.DefaultCompression), 0)).Encrypt(ctx, msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	if _, err := New(fakeCrypter{}, WithMaxDecompressedSize(100)).Decrypt(ctx, encrypted); err == nil {
+		t.Error("Expected error decrypting message exceeding the maximum decompressed size")
+	}
+
+	if _, err := New(fakeCrypter{}, WithMaxDecompressedSize(int64(proto.Size(msg)))).Decrypt(ctx, encrypted); err != nil {
+		t.Errorf("Error decrypting message of the maximum decompressed size: %v", err)
+	}
+}
+
+func TestCompressionInvalidCompressor(t *testing.T) {
+	ctx := context.Background()
+
+	msg := testprotos.TestMessage_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("test"),
+	}.Build()
+
+	for _, tt := range []struct {
+		explanation string
+		option      Option
+	}{
+		{
+			explanation: "Nil compressor",
+			option:      WithCompression(nil, 0),
+		},
+		{
+			explanation: "Compressor without name",
+			option:      WithCompression(namedCompressor{}, 0),
+		},
+		{
+			explanation: "Nil decompressor",
+			option:      WithDecompressors(nil),
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			p := New(fakeCrypter{}, tt.option)
+
+			if _, err := p.Encrypt(ctx, msg); err == nil {
+				t.Error("Expected error encrypting message with invalid compressor")
+			}
+
+			envelope, err := New(fakeCrypter{}).Encrypt(ctx, msg)
+			if err != nil {
+				t.Fatalf("Error encrypting message: %v", err)
+			}
+
+			if _, err := p.Decrypt(ctx, envelope); err == nil {
+				t.Error("Expected error decrypting message with invalid compressor")
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package protoprivacy
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	"github.com/google/cel-go/cel"
+	celast "github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// conditions contains the compiled CEL conditions of personal data fields, keyed by the field's full name.
+type conditions map[protoreflect.FullName]cel.Program
+
+// isPersonalData reports whether the field in the parent message is personal data. Fields without a condition are
+// always personal data. Fields with a condition are personal data if the condition evaluates to true or cannot be
+// evaluated.
+func (c conditions) isPersonalData(parent protoreflect.Message, fd protoreflect.FieldDescriptor) bool {
+	program, ok := c[fd.FullName()]
+	if !ok {
+		return true
+	}
+
+	vars, err := cel.ContextProtoVars(parent.Interface())
+	if err != nil {
+		return true
+	}
+
+	result, _, err := program.Eval(vars)
+	if err != nil {
+		return true
+	}
+
+	isPersonalData, ok := result.Value().(bool)
+
+	return !ok || isPersonalData
+}
+
+// compileConditions compiles and type-checks the conditions of all personal data fields in the message. The fields
+// of the message containing a personal data field are available as variables in its condition. Conditions must not
+// reference fields that are changed when the message is redacted, so that they evaluate to the same result on the
+// original and the redacted message.
+func compileConditions(msg protoreflect.MessageDescriptor) (conditions, error) {
+	var errs error
+
+	compiled := make(conditions)
+	envs := make(map[protoreflect.FullName]*cel.Env)
+
+	walkFields(msg, func(f protoreflect.FieldDescriptor) bool {
+		condition := fieldCondition(f)
+		if condition == "" {
+			return false
+		}
+
+		if _, ok := compiled[f.FullName()]; ok {
+			return false
+		}
+
+		containingMessage := f.ContainingMessage()
+
+		env, ok := envs[containingMessage.FullName()]
+		if !ok {
+			var err error
+
+			env, err = cel.NewEnv(cel.DeclareContextProto(containingMessage))
+			if err != nil {
+				errs = errors.Join(errs, fmt.Errorf("error creating condition environment for message %s in %s: %w", containingMessage.FullName(), msg.ParentFile().Path(), err))
+				return false
+			}
+
+			envs[containingMessage.FullName()] = env
+		}
+
+		ast, issues := env.Compile(condition)
+		if issues.Err() != nil {
+			errs = errors.Join(errs, fmt.Errorf("field %s has an invalid condition in %s: %w", f.FullName(), msg.ParentFile().Path(), issues.Err()))
+			return false
+		}
+
+		if ast.OutputType() != cel.BoolType {
+			errs = errors.Join(errs, fmt.Errorf("field %s has a condition with type %s but it must be bool in %s", f.FullName(), ast.OutputType(), msg.ParentFile().Path()))
+			return false
+		}
+
+		for _, referenced := range redactedFieldReferences(ast, containingMessage) {
+			errs = errors.Join(errs, fmt.Errorf("field %s has a condition referencing field %s that is changed when redacting in %s", f.FullName(), referenced.FullName(), msg.ParentFile().Path()))
+		}
+
+		program, err := env.Program(ast)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("error creating program for condition of field %s in %s: %w", f.FullName(), msg.ParentFile().Path(), err))
+			return false
+		}
+
+		compiled[f.FullName()] = program
+
+		return false
+	})
+
+	return compiled, errs
+}
+
+// redactedFieldReferences returns the fields referenced by the checked condition that are changed when the message is
+// redacted: personal data fields and the target fields of blind indexes.
+func redactedFieldReferences(checked *cel.Ast, containingMessage protoreflect.MessageDescriptor) []protoreflect.FieldDescriptor {
+	var referenced []protoreflect.FieldDescriptor
+
+	native := checked.NativeRep()
+
+	celast.PostOrderVisit(native.Expr(), celast.NewExprVisitor(func(e celast.Expr) {
+		var fd protoreflect.FieldDescriptor
+
+		switch e.Kind() {
+		case celast.IdentKind:
+			fd = containingMessage.Fields().ByName(protoreflect.Name(e.AsIdent()))
+		case celast.SelectKind:
+			operandType := native.GetType(e.AsSelect().Operand().ID())
+			if operandType.Kind() != types.StructKind {
+				return
+			}
+
+			descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(operandType.TypeName()))
+			if err != nil {
+				return
+			}
+
+			if md, ok := descriptor.(protoreflect.MessageDescriptor); ok {
+				fd = md.Fields().ByName(protoreflect.Name(e.AsSelect().FieldName()))
+			}
+		}
+
+		if fd != nil && (fieldHasPersonalData(fd) || fieldIsBlindIndexTarget(fd)) && !slices.Contains(referenced, fd) {
+			referenced = append(referenced, fd)
+		}
+	}))
+
+	return referenced
+}
+
+// fieldIsBlindIndexTarget reports whether the field is the target field of a blind index of a field in the same
+// message.
+func fieldIsBlindIndexTarget(f protoreflect.FieldDescriptor) bool {
+	fields := f.ContainingMessage().Fields()
+
+	for i := range fields.Len() {
+		field := fields.Get(i)
+
+		if !fieldHasPersonalData(field) || !fieldHasBlindIndex(field) {
+			continue
+		}
+
+		if proto.GetExtension(field.Options(), privacy.E_Field).(*privacy.PrivacyFieldOptions).GetPersonalData().GetBlindIndex().GetField() == string(f.Name()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func fieldCondition(f protoreflect.FieldDescriptor) string {
+	options := f.Options()
+
+	if options == nil {
+		return ""
+	}
+
+	privacyField := proto.GetExtension(options, privacy.E_Field).(*privacy.PrivacyFieldOptions)
+	if privacyField == nil {
+		return ""
+	}
+
+	return privacyField.GetPersonalData().GetCondition()
+}
@@ -0,0 +1,113 @@
+package protoprivacy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestConditionalPersonalData(t *testing.T) {
+	for _, tt := range []struct {
+		explanation string
+		proto       *testprotos.TestCondition
+		redacted    *testprotos.TestCondition
+		shredded    *testprotos.TestCondition
+	}{
+		{
+			explanation: "Conditions true",
+			proto: testprotos.TestCondition_builder{
+				Id:      proto.String("123"),
+				Channel: proto.String("customer"),
+				Notes:   proto.String("notes"),
+				Address: testprotos.TestCondition_Address_builder{
+					Country: proto.String("DE"),
+					Line1:   proto.String("line1"),
+				}.Build(),
+			}.Build(),
+			redacted: testprotos.TestCondition_builder{
+				Id:      proto.String("123"),
+				Channel: proto.String("customer"),
+				Notes:   proto.String(""),
+				Address: testprotos.TestCondition_Address_builder{
+					Country: proto.String("DE"),
+					Line1:   proto.String(""),
+				}.Build(),
+			}.Build(),
+			shredded: testprotos.TestCondition_builder{
+				Id:      proto.String("123"),
+				Channel: proto.String("customer"),
+				Notes:   proto.String("hidden"),
+				Address: testprotos.TestCondition_Address_builder{
+					Country: proto.String("DE"),
+				}.Build(),
+			}.Build(),
+		},
+		{
+			explanation: "Conditions false",
+			proto: testprotos.TestCondition_builder{
+				Id:      proto.String("123"),
+				Channel: proto.String("internal"),
+				Notes:   proto.String("notes"),
+				Address: testprotos.TestCondition_Address_builder{
+					Country: proto.String("AU"),
+					Line1:   proto.String("line1"),
+				}.Build(),
+			}.Build(),
+			redacted: testprotos.TestCondition_builder{
+				Id:      proto.String("123"),
+				Channel: proto.String("internal"),
+				Notes:   proto.String("notes"),
+				Address: testprotos.TestCondition_Address_builder{
+					Country: proto.String("AU"),
+					Line1:   proto.String("line1"),
+				}.Build(),
+			}.Build(),
+			shredded: testprotos.TestCondition_builder{
+				Id:      proto.String("123"),
+				Channel: proto.String("internal"),
+				Notes:   proto.String("notes"),
+				Address: testprotos.TestCondition_Address_builder{
+					Country: proto.String("AU"),
+					Line1:   proto.String("line1"),
+				}.Build(),
+			}.Build(),
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			envelope, err := New(fakeCrypter{}).Encrypt(context.Background(), tt.proto)
+			if err != nil {
+				t.Fatalf("Error encrypting message: %v", err)
+			}
+
+			redacted, err := envelope.(*privacy.Envelope).GetMessage().UnmarshalNew()
+			if err != nil {
+				t.Fatalf("Error unmarshaling redacted message: %v", err)
+			}
+
+			if !proto.Equal(tt.redacted, redacted) {
+				t.Errorf("Redacted message does not match expected message: %v", redacted)
+			}
+
+			decrypted, err := New(fakeCrypter{}).Decrypt(context.Background(), envelope)
+			if err != nil {
+				t.Fatalf("Error decrypting message: %v", err)
+			}
+
+			if !proto.Equal(tt.proto, decrypted) {
+				t.Error("Decrypted message does not match original message")
+			}
+
+			shredded, err := New(fakeDeletedDataSubjectCrypter{}).Decrypt(context.Background(), envelope)
+			if err != nil {
+				t.Fatalf("Error decrypting message: %v", err)
+			}
+
+			if !proto.Equal(tt.shredded, shredded) {
+				t.Errorf("Decrypted message after deletion does not match expected message: %v", shredded)
+			}
+		})
+	}
+}
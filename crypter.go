@@ -4,6 +4,10 @@ import (
 	"context"
 )
 
+// Crypter encrypts and decrypts the personal data of data subjects. If data cannot be decrypted because the key of the
+// data subject has been deleted, Decrypt should return ErrKeyShredded. For compatibility, returning nil as the
+// cleartext and nil as the error (nil, nil) is treated the same way, so Decrypt must return a non-nil cleartext for
+// empty data.
 type Crypter interface {
 	Encrypt(ctx context.Context, dataSubjectID string, cleartext []byte) ([]byte, error)
 	Decrypt(ctx context.Context, dataSubjectID string, ciphertext []byte) ([]byte, error)
@@ -0,0 +1,532 @@
+// Package crypttest provides a conformance test suite for implementations of protoprivacy.Crypter.
+//
+// Run it from a test of the crypter:
+//
+//	func TestConformance(t *testing.T) {
+//		crypttest.TestCrypter(t, func(t *testing.T) protoprivacy.Crypter {
+//			return mycrypter.New(...)
+//		})
+//	}
+//
+// Shredding is only tested if the crypter implements protoprivacy.Shredder, and shredding by suffix if it implements
+// protoprivacy.SuffixShredder. Run the tests with -race to detect data
+// races.
+package crypttest
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/Boostport/protoprivacy"
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	concurrency         = 8
+	concurrentDataCount = 20
+	distinctCount       = 100
+)
+
+// Factory returns a new instance of the crypter under test. It is called once per test, so that tests do not affect
+// each other.
+type Factory func(t *testing.T) protoprivacy.Crypter
+
+// TestCrypter tests that the crypters returned by factory satisfy the contract of protoprivacy.Crypter.
+func TestCrypter(t *testing.T, factory Factory) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, c protoprivacy.Crypter)
+	}{
+		{name: "RoundTrip", test: testRoundTrip},
+		{name: "DistinctCiphertexts", test: testDistinctCiphertexts},
+		{name: "Tampering", test: testTampering},
+		{name: "DataSubjectBinding", test: testDataSubjectBinding},
+		{name: "ContextCanceled", test: testContextCanceled},
+		{name: "Concurrency", test: testConcurrency},
+		{name: "Privacy", test: testPrivacy},
+		{name: "Shred", test: testShred},
+		{name: "ShredPrefix", test: testShredPrefix},
+		{name: "ShredSuffix", test: testShredSuffix},
+		{name: "PrivacyShred", test: testPrivacyShred},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, factory(t))
+		})
+	}
+}
+
+func testRoundTrip(t *testing.T, c protoprivacy.Crypter) {
+	ctx := context.Background()
+	dataSubjectID := newDataSubjectID(t)
+
+	for _, tt := range []struct {
+		explanation string
+		cleartext   []byte
+	}{
+		{
+			explanation: "Empty",
+			cleartext:   []byte{},
+		},
+		{
+			explanation: "Single byte",
+			cleartext:   []byte{0},
+		},
+		{
+			explanation: "Text",
+			cleartext:   []byte("test"),
+		},
+		{
+			explanation: "Large",
+			cleartext:   randomBytes(t, 1<<20),
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			ciphertext, err := c.Encrypt(ctx, dataSubjectID, tt.cleartext)
+			if err != nil {
+				t.Fatalf("Error encrypting: %v", err)
+			}
+
+			// Short cleartexts can appear in ciphertexts by chance
+			if len(tt.cleartext) >= 4 && bytes.Contains(ciphertext, tt.cleartext) {
+				t.Error("Ciphertext contains the cleartext")
+			}
+
+			cleartext, err := c.Decrypt(ctx, dataSubjectID, ciphertext)
+			if err != nil {
+				t.Fatalf("Error decrypting: %v", err)
+			}
+
+			// A nil cleartext signals a shredded key, so an empty cleartext must be returned as an empty non-nil slice
+			if cleartext == nil {
+				t.Fatal("Decrypt returned a nil cleartext, which signals a shredded key")
+			}
+
+			if !bytes.Equal(cleartext, tt.cleartext) {
+				t.Errorf("Decrypted cleartext does not match original cleartext, expected %d bytes, got %d bytes", len(tt.cleartext), len(cleartext))
+			}
+		})
+	}
+}
+
+func testDistinctCiphertexts(t *testing.T, c protoprivacy.Crypter) {
+	ctx := context.Background()
+	dataSubjectID := newDataSubjectID(t)
+	seen := make(map[string]struct{}, distinctCount)
+
+	for range distinctCount {
+		ciphertext, err := c.Encrypt(ctx, dataSubjectID, []byte("test"))
+		if err != nil {
+			t.Fatalf("Error encrypting: %v", err)
+		}
+
+		if _, ok := seen[string(ciphertext)]; ok {
+			t.Fatal("Encrypting identical cleartexts returned identical ciphertexts, which suggests nonce reuse")
+		}
+
+		seen[string(ciphertext)] = struct{}{}
+	}
+}
+
+func testTampering(t *testing.T, c protoprivacy.Crypter) {
+	ctx := context.Background()
+	dataSubjectID := newDataSubjectID(t)
+
+	ciphertext, err := c.Encrypt(ctx, dataSubjectID, []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	for i := range ciphertext {
+		tampered := bytes.Clone(ciphertext)
+		tampered[i] ^= 1
+
+		if cleartext, err := c.Decrypt(ctx, dataSubjectID, tampered); err == nil && cleartext != nil {
+			t.Errorf("Decrypted ciphertext with byte %d tampered with, expected an error", i)
+		}
+	}
+
+	for _, size := range []int{0, len(ciphertext) / 2, len(ciphertext) - 1} {
+		if cleartext, err := c.Decrypt(ctx, dataSubjectID, ciphertext[:size]); err == nil && cleartext != nil {
+			t.Errorf("Decrypted ciphertext truncated to %d bytes, expected an error", size)
+		}
+	}
+
+	if cleartext, err := c.Decrypt(ctx, dataSubjectID, append(bytes.Clone(ciphertext), 0)); err == nil && cleartext != nil {
+		t.Error("Decrypted ciphertext with a byte appended, expected an error")
+	}
+}
+
+func testDataSubjectBinding(t *testing.T, c protoprivacy.Crypter) {
+	ctx := context.Background()
+	dataSubjectID := newDataSubjectID(t)
+	otherDataSubjectID := newDataSubjectID(t)
+
+	ciphertext, err := c.Encrypt(ctx, dataSubjectID, []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	// Make sure the other data subject has a key
+	if _, err := c.Encrypt(ctx, otherDataSubjectID, []byte("test")); err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if cleartext, err := c.Decrypt(ctx, otherDataSubjectID, ciphertext); err == nil && cleartext != nil {
+		t.Error("Decrypted ciphertext of a data subject as another data subject, expected an error")
+	}
+}
+
+func testContextCanceled(t *testing.T, c protoprivacy.Crypter) {
+	dataSubjectID := newDataSubjectID(t)
+
+	ciphertext, err := c.Encrypt(context.Background(), dataSubjectID, []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Crypters may ignore a canceled context, but must not report the key as shredded, as that would clear the personal
+	// data of the message
+	cleartext, err := c.Decrypt(ctx, dataSubjectID, ciphertext)
+	if keyUnavailable(cleartext, err) {
+		t.Errorf("Decrypting with a canceled context reported the key as shredded, got %v", err)
+	} else if err == nil && !bytes.Equal(cleartext, []byte("test")) {
+		t.Errorf("Decrypted cleartext does not match original cleartext, expected %q, got %q", "test", cleartext)
+	}
+
+	// Data encrypted with a canceled context must be decryptable if no error was returned
+	otherDataSubjectID := newDataSubjectID(t)
+
+	if ciphertext, err := c.Encrypt(ctx, otherDataSubjectID, []byte("test")); err == nil {
+		requireDecrypted(t, c, otherDataSubjectID, ciphertext)
+	}
+}
+
+func testConcurrency(t *testing.T, c protoprivacy.Crypter) {
+	ctx := context.Background()
+
+	// All goroutines encrypt for the same data subjects, so keys are created concurrently
+	dataSubjectIDs := []string{newDataSubjectID(t), newDataSubjectID(t)}
+
+	type result struct {
+		dataSubjectID string
+		cleartext     []byte
+		ciphertext    []byte
+	}
+
+	results := make([][]result, concurrency)
+	errs := make([]error, concurrency)
+
+	var wg sync.WaitGroup
+
+	for i := range concurrency {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := range concurrentDataCount {
+				dataSubjectID := dataSubjectIDs[j%len(dataSubjectIDs)]
+				cleartext := []byte(dataSubjectID + hex.EncodeToString([]byte{byte(i), byte(j)}))
+
+				ciphertext, err := c.Encrypt(ctx, dataSubjectID, cleartext)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+
+				decrypted, err := c.Decrypt(ctx, dataSubjectID, ciphertext)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+
+				if !bytes.Equal(decrypted, cleartext) {
+					errs[i] = errors.New("decrypted cleartext does not match original cleartext")
+					return
+				}
+
+				results[i] = append(results[i], result{dataSubjectID: dataSubjectID, cleartext: cleartext, ciphertext: ciphertext})
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		t.Fatalf("Error encrypting and decrypting concurrently: %v", err)
+	}
+
+	// Data encrypted while keys were being created concurrently must remain decryptable
+	for _, results := range results {
+		for _, r := range results {
+			cleartext, err := c.Decrypt(ctx, r.dataSubjectID, r.ciphertext)
+			if err != nil {
+				t.Fatalf("Error decrypting data encrypted concurrently: %v", err)
+			}
+
+			if !bytes.Equal(cleartext, r.cleartext) {
+				t.Fatalf("Decrypted cleartext does not match original cleartext, expected %q, got %q", r.cleartext, cleartext)
+			}
+		}
+	}
+}
+
+func testPrivacy(t *testing.T, c protoprivacy.Crypter) {
+	ctx := context.Background()
+	p := protoprivacy.New(c)
+	msg := newTestMessage(t)
+
+	encrypted, err := p.Encrypt(ctx, msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	decrypted, report, err := p.DecryptWithReport(ctx, encrypted)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if report.Outcome != protoprivacy.DecryptOutcomeDecrypted {
+		t.Errorf("Expected outcome %s, got %s", protoprivacy.DecryptOutcomeDecrypted, report.Outcome)
+	}
+
+	if !proto.Equal(decrypted, msg) {
+		t.Errorf("Decrypted message does not match original message, expected %v, got %v", msg, decrypted)
+	}
+}
+
+func testShred(t *testing.T, c protoprivacy.Crypter) {
+	ctx := context.Background()
+	shredder := requireShredder(t, c)
+	dataSubjectID := newDataSubjectID(t)
+	otherDataSubjectID := newDataSubjectID(t)
+
+	ciphertext, err := c.Encrypt(ctx, dataSubjectID, []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	otherCiphertext, err := c.Encrypt(ctx, otherDataSubjectID, []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if shredded, err := shredder.IsShredded(ctx, dataSubjectID); err != nil || shredded {
+		t.Errorf("Expected data subject not to be shredded, got %t, %v", shredded, err)
+	}
+
+	if err := shredder.Shred(ctx, dataSubjectID); err != nil {
+		t.Fatalf("Error shredding data subject: %v", err)
+	}
+
+	if shredded, err := shredder.IsShredded(ctx, dataSubjectID); err != nil || !shredded {
+		t.Errorf("Expected data subject to be shredded, got %t, %v", shredded, err)
+	}
+
+	requireShredded(t, c, dataSubjectID, ciphertext)
+	requireDecrypted(t, c, otherDataSubjectID, otherCiphertext)
+
+	// Shredding an already shredded data subject must succeed
+	if err := shredder.Shred(ctx, dataSubjectID); err != nil {
+		t.Fatalf("Error shredding shredded data subject: %v", err)
+	}
+
+	// Encrypting new data for a shredded data subject must not make the shredded data decryptable or corrupt
+	newCiphertext, err := c.Encrypt(ctx, dataSubjectID, []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting for shredded data subject: %v", err)
+	}
+
+	requireDecrypted(t, c, dataSubjectID, newCiphertext)
+	requireShredded(t, c, dataSubjectID, ciphertext)
+}
+
+func testShredPrefix(t *testing.T, c protoprivacy.Crypter) {
+	ctx := context.Background()
+	shredder := requireShredder(t, c)
+	prefix := newDataSubjectID(t) + ":"
+	dataSubjectIDs := []string{prefix + "1", prefix + "2"}
+	otherDataSubjectID := newDataSubjectID(t)
+
+	ciphertexts := make([][]byte, len(dataSubjectIDs))
+
+	for i, dataSubjectID := range dataSubjectIDs {
+		ciphertext, err := c.Encrypt(ctx, dataSubjectID, []byte("test"))
+		if err != nil {
+			t.Fatalf("Error encrypting: %v", err)
+		}
+
+		ciphertexts[i] = ciphertext
+	}
+
+	otherCiphertext, err := c.Encrypt(ctx, otherDataSubjectID, []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if err := shredder.ShredPrefix(ctx, prefix); err != nil {
+		t.Fatalf("Error shredding prefix: %v", err)
+	}
+
+	for i, dataSubjectID := range dataSubjectIDs {
+		requireShredded(t, c, dataSubjectID, ciphertexts[i])
+	}
+
+	requireDecrypted(t, c, otherDataSubjectID, otherCiphertext)
+}
+
+func testShredSuffix(t *testing.T, c protoprivacy.Crypter) {
+	ctx := context.Background()
+
+	shredder, ok := c.(protoprivacy.SuffixShredder)
+	if !ok {
+		t.Skip("Crypter does not implement protoprivacy.SuffixShredder")
+	}
+
+	suffix := "@" + newDataSubjectID(t)
+	dataSubjectIDs := []string{newDataSubjectID(t) + suffix, newDataSubjectID(t) + suffix}
+	otherDataSubjectID := newDataSubjectID(t)
+
+	ciphertexts := make([][]byte, len(dataSubjectIDs))
+
+	for i, dataSubjectID := range dataSubjectIDs {
+		ciphertext, err := c.Encrypt(ctx, dataSubjectID, []byte("test"))
+		if err != nil {
+			t.Fatalf("Error encrypting: %v", err)
+		}
+
+		ciphertexts[i] = ciphertext
+	}
+
+	otherCiphertext, err := c.Encrypt(ctx, otherDataSubjectID, []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if err := shredder.ShredSuffix(ctx, suffix); err != nil {
+		t.Fatalf("Error shredding suffix: %v", err)
+	}
+
+	for i, dataSubjectID := range dataSubjectIDs {
+		requireShredded(t, c, dataSubjectID, ciphertexts[i])
+	}
+
+	requireDecrypted(t, c, otherDataSubjectID, otherCiphertext)
+}
+
+func testPrivacyShred(t *testing.T, c protoprivacy.Crypter) {
+	ctx := context.Background()
+	requireShredder(t, c)
+	p := protoprivacy.New(c)
+	msg := newTestMessage(t)
+
+	encrypted, err := p.Encrypt(ctx, msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	if err := p.Shred(ctx, encrypted); err != nil {
+		t.Fatalf("Error shredding data subject: %v", err)
+	}
+
+	shredded, err := p.IsShredded(ctx, encrypted)
+	if err != nil {
+		t.Fatalf("Error checking if data subject is shredded: %v", err)
+	}
+
+	if !shredded {
+		t.Error("Expected data subject to be shredded")
+	}
+
+	decrypted, report, err := p.DecryptWithReport(ctx, encrypted)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if report.Outcome != protoprivacy.DecryptOutcomeKeyShredded && report.Outcome != protoprivacy.DecryptOutcomeKeyNotFound {
+		t.Errorf("Expected outcome %s or %s, got %s", protoprivacy.DecryptOutcomeKeyShredded, protoprivacy.DecryptOutcomeKeyNotFound, report.Outcome)
+	}
+
+	expected := testprotos.TestFallbackTypes_builder{
+		Id:     proto.String(msg.GetId()),
+		Data14: proto.String("test"),
+	}.Build()
+
+	if !proto.Equal(decrypted, expected) {
+		t.Errorf("Expected fallback values for shredded data subject, expected %v, got %v", expected, decrypted)
+	}
+}
+
+// keyUnavailable reports whether Decrypt signaled that the key of the data subject is shredded or cannot be found.
+func keyUnavailable(cleartext []byte, err error) bool {
+	if err != nil {
+		return errors.Is(err, protoprivacy.ErrKeyShredded) || errors.Is(err, protoprivacy.ErrKeyNotFound)
+	}
+
+	return cleartext == nil
+}
+
+func requireShredder(t *testing.T, c protoprivacy.Crypter) protoprivacy.Shredder {
+	t.Helper()
+
+	shredder, ok := c.(protoprivacy.Shredder)
+	if !ok {
+		t.Skip("Crypter does not implement protoprivacy.Shredder")
+	}
+
+	return shredder
+}
+
+func requireShredded(t *testing.T, c protoprivacy.Crypter, dataSubjectID string, ciphertext []byte) {
+	t.Helper()
+
+	cleartext, err := c.Decrypt(context.Background(), dataSubjectID, ciphertext)
+	if !keyUnavailable(cleartext, err) {
+		t.Errorf("Expected nil, nil, ErrKeyShredded or ErrKeyNotFound decrypting data of shredded data subject %s, got %q, %v", dataSubjectID, cleartext, err)
+	}
+}
+
+func requireDecrypted(t *testing.T, c protoprivacy.Crypter, dataSubjectID string, ciphertext []byte) {
+	t.Helper()
+
+	cleartext, err := c.Decrypt(context.Background(), dataSubjectID, ciphertext)
+	if err != nil {
+		t.Fatalf("Error decrypting data of data subject %s: %v", dataSubjectID, err)
+	}
+
+	if !bytes.Equal(cleartext, []byte("test")) {
+		t.Errorf("Decrypted cleartext of data subject %s does not match original cleartext, expected %q, got %q", dataSubjectID, "test", cleartext)
+	}
+}
+
+func newTestMessage(t *testing.T) *testprotos.TestFallbackTypes {
+	return testprotos.TestFallbackTypes_builder{
+		Id:     proto.String(newDataSubjectID(t)),
+		Data14: proto.String("secret"),
+	}.Build()
+}
+
+// newDataSubjectID returns a random data subject id, so that tests do not affect each other if crypters share state.
+func newDataSubjectID(t *testing.T) string {
+	return "crypttest-" + hex.EncodeToString(randomBytes(t, 8))
+}
+
+func randomBytes(t *testing.T, size int) []byte {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		t.Fatalf("Error generating random bytes: %v", err)
+	}
+
+	return b
+}
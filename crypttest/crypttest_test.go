@@ -0,0 +1,37 @@
+package crypttest
+
+import (
+	"testing"
+
+	"github.com/Boostport/protoprivacy"
+	"github.com/Boostport/protoprivacy/aesgcm"
+	"github.com/Boostport/protoprivacy/keystore"
+)
+
+func TestRotatingCrypter(t *testing.T) {
+	TestCrypter(t, func(t *testing.T) protoprivacy.Crypter {
+		c, err := protoprivacy.NewRotatingCrypter(2, map[uint64]protoprivacy.Crypter{
+			1: aesgcm.New(keystore.NewMemory()),
+			2: aesgcm.New(keystore.NewMemory()),
+		})
+		if err != nil {
+			t.Fatalf("Error creating rotating crypter: %v", err)
+		}
+
+		return c
+	})
+}
+
+func TestMigratingCrypter(t *testing.T) {
+	TestCrypter(t, func(t *testing.T) protoprivacy.Crypter {
+		return protoprivacy.NewMigratingCrypter(aesgcm.New(keystore.NewMemory()), aesgcm.New(keystore.NewMemory()))
+	})
+}
+
+func TestRouterCrypter(t *testing.T) {
+	TestCrypter(t, func(t *testing.T) protoprivacy.Crypter {
+		return protoprivacy.NewRouterCrypter(map[string]protoprivacy.Crypter{
+			"crypttest-": aesgcm.New(keystore.NewMemory()),
+		}, aesgcm.New(keystore.NewMemory()))
+	})
+}
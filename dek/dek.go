@@ -0,0 +1,208 @@
+// Package dek provides a protoprivacy.Crypter that uses envelope encryption: each data subject has its own data
+// encryption key (DEK), which is generated on first use, wrapped by a key encryption key (KEK) using a KeyWrapper and
+// stored in a keystore.KeyStore. Deleting the wrapped DEK of a data subject from the store shreds its data.
+package dek
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Boostport/protoprivacy"
+	"github.com/Boostport/protoprivacy/internal/aead"
+	"github.com/Boostport/protoprivacy/keycache"
+	"github.com/Boostport/protoprivacy/keystore"
+)
+
+const (
+	defaultCacheSize = 10000
+	defaultCacheTTL  = time.Minute
+)
+
+// Option configures a Crypter.
+type Option func(*Crypter)
+
+// WithCacheSize sets the maximum number of unwrapped DEKs that are cached. A size of 0 disables the cache. The default
+// is 10000.
+func WithCacheSize(size int) Option {
+	return func(c *Crypter) {
+		c.cacheOptions = append(c.cacheOptions, keycache.WithSize(size))
+	}
+}
+
+// WithCacheTTL sets how long unwrapped DEKs are cached. The default is 1 minute.
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(c *Crypter) {
+		c.cacheOptions = append(c.cacheOptions, keycache.WithTTL(ttl))
+	}
+}
+
+// WithShredNotifier subscribes the Crypter to shred events published by notifier, evicting shredded data subjects from
+// the cache, and publishes an event whenever a data subject is shredded through the Crypter. Call Close to unsubscribe.
+func WithShredNotifier(notifier protoprivacy.ShredNotifier) Option {
+	return func(c *Crypter) {
+		c.cacheOptions = append(c.cacheOptions, keycache.WithShredNotifier(notifier))
+	}
+}
+
+// Crypter encrypts and decrypts data with per data subject DEKs. It implements protoprivacy.Crypter.
+type Crypter struct {
+	provider     *keyHandleProvider
+	cache        *keycache.Crypter
+	cacheOptions []keycache.Option
+}
+
+// New returns a Crypter that stores DEKs in store after wrapping them with wrapper. DEKs are unwrapped once and cached
+// using a keycache.Crypter, so that reading them from the store and unwrapping them is not needed for every call. The
+// cache size and TTL limit how long unwrapped DEKs are kept in memory. Shred removes DEKs from both the store and the
+// cache. Because a cached DEK remains usable until it expires,
+// Invalidate must be called if a DEK is deleted from the store directly.
+func New(store keystore.KeyStore, wrapper KeyWrapper, opts ...Option) *Crypter {
+	c := &Crypter{
+		provider: &keyHandleProvider{
+			store:   store,
+			wrapper: wrapper,
+		},
+		cacheOptions: []keycache.Option{
+			keycache.WithSize(defaultCacheSize),
+			keycache.WithTTL(defaultCacheTTL),
+		},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.cache = keycache.New(c.provider, c.cacheOptions...)
+
+	return c
+}
+
+// Close unsubscribes the Crypter from its shred notifier, if any.
+func (c *Crypter) Close() {
+	c.cache.Close()
+}
+
+func (c *Crypter) Encrypt(ctx context.Context, dataSubjectID string, cleartext []byte) ([]byte, error) {
+	return c.cache.Encrypt(ctx, dataSubjectID, cleartext)
+}
+
+func (c *Crypter) Decrypt(ctx context.Context, dataSubjectID string, ciphertext []byte) ([]byte, error) {
+	return c.cache.Decrypt(ctx, dataSubjectID, ciphertext)
+}
+
+// KeyHandle returns a key handle for the unwrapped DEK of the data subject id, so the Crypter can be wrapped by a
+// keycache.Crypter. The cache of the Crypter is not consulted. It implements keycache.KeyHandleProvider.
+func (c *Crypter) KeyHandle(ctx context.Context, dataSubjectID string, create bool) (keycache.KeyHandle, error) {
+	return c.provider.KeyHandle(ctx, dataSubjectID, create)
+}
+
+// Shred deletes the wrapped DEK of the data subject id from the store and the cache, shredding its data. It implements
+// protoprivacy.Shredder.
+func (c *Crypter) Shred(ctx context.Context, dataSubjectID string) error {
+	return c.cache.Shred(ctx, dataSubjectID)
+}
+
+// ShredPrefix deletes the wrapped DEKs of all data subject ids starting with the prefix from the store and the cache.
+func (c *Crypter) ShredPrefix(ctx context.Context, prefix string) error {
+	return c.cache.ShredPrefix(ctx, prefix)
+}
+
+// ShredSuffix deletes the wrapped DEKs of all data subject ids ending with the suffix from the store and the cache. The
+// key store must implement keystore.SuffixDeleter.
+func (c *Crypter) ShredSuffix(ctx context.Context, suffix string) error {
+	return c.cache.ShredSuffix(ctx, suffix)
+}
+
+// IsShredded reports whether the data subject id does not have a wrapped DEK in the store. The cache is not consulted.
+func (c *Crypter) IsShredded(ctx context.Context, dataSubjectID string) (bool, error) {
+	return c.provider.IsShredded(ctx, dataSubjectID)
+}
+
+// Invalidate removes the cached DEK of the data subject.
+func (c *Crypter) Invalidate(dataSubjectID string) {
+	c.cache.Invalidate(dataSubjectID)
+}
+
+// keyHandleProvider returns key handles for the unwrapped DEKs in the store and deletes them when shredding.
+type keyHandleProvider struct {
+	store   keystore.KeyStore
+	wrapper KeyWrapper
+}
+
+func (p *keyHandleProvider) KeyHandle(ctx context.Context, dataSubjectID string, create bool) (keycache.KeyHandle, error) {
+	if create {
+		wrappedKey, err := keystore.GetOrCreate(ctx, p.store, dataSubjectID, func() ([]byte, error) {
+			key, err := aead.NewKey()
+			if err != nil {
+				return nil, err
+			}
+
+			wrappedKey, err := p.wrapper.Wrap(ctx, dataSubjectID, key)
+			if err != nil {
+				return nil, fmt.Errorf("error wrapping key: %w", err)
+			}
+
+			return wrappedKey, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return p.unwrap(ctx, dataSubjectID, wrappedKey)
+	}
+
+	wrappedKey, err := p.store.Get(ctx, dataSubjectID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting key: %w", err)
+	}
+
+	if wrappedKey == nil {
+		return nil, protoprivacy.ErrKeyShredded
+	}
+
+	return p.unwrap(ctx, dataSubjectID, wrappedKey)
+}
+
+func (p *keyHandleProvider) Shred(ctx context.Context, dataSubjectID string) error {
+	if err := p.store.Delete(ctx, dataSubjectID); err != nil {
+		return fmt.Errorf("error deleting key: %w", err)
+	}
+
+	return nil
+}
+
+func (p *keyHandleProvider) ShredPrefix(ctx context.Context, prefix string) error {
+	if err := p.store.DeletePrefix(ctx, prefix); err != nil {
+		return fmt.Errorf("error deleting keys: %w", err)
+	}
+
+	return nil
+}
+
+func (p *keyHandleProvider) ShredSuffix(ctx context.Context, suffix string) error {
+	if err := keystore.DeleteSuffix(ctx, p.store, suffix); err != nil {
+		return fmt.Errorf("error deleting keys: %w", err)
+	}
+
+	return nil
+}
+
+func (p *keyHandleProvider) IsShredded(ctx context.Context, dataSubjectID string) (bool, error) {
+	wrappedKey, err := p.store.Get(ctx, dataSubjectID)
+	if err != nil {
+		return false, fmt.Errorf("error getting key: %w", err)
+	}
+
+	return wrappedKey == nil, nil
+}
+
+// unwrap unwraps the wrapped DEK of the data subject id and returns a key handle holding the unwrapped DEK.
+func (p *keyHandleProvider) unwrap(ctx context.Context, dataSubjectID string, wrappedKey []byte) (keycache.KeyHandle, error) {
+	key, err := p.wrapper.Unwrap(ctx, dataSubjectID, wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("error unwrapping key: %w", err)
+	}
+
+	return aead.NewHandle(key, dataSubjectID), nil
+}
@@ -0,0 +1,295 @@
+package dek
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Boostport/protoprivacy"
+	"github.com/Boostport/protoprivacy/crypttest"
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"github.com/Boostport/protoprivacy/keystore"
+	"google.golang.org/protobuf/proto"
+)
+
+type countingKeyStore struct {
+	keystore.KeyStore
+	gets atomic.Int32
+}
+
+func (s *countingKeyStore) Get(ctx context.Context, id string) ([]byte, error) {
+	s.gets.Add(1)
+	return s.KeyStore.Get(ctx, id)
+}
+
+// countingKeyWrapper is a KeyWrapper that counts unwraps, which call the key management service in production.
+type countingKeyWrapper struct {
+	KeyWrapper
+	unwraps atomic.Int32
+}
+
+func (w *countingKeyWrapper) Unwrap(ctx context.Context, dataSubjectID string, wrappedKey []byte) ([]byte, error) {
+	w.unwraps.Add(1)
+	return w.KeyWrapper.Unwrap(ctx, dataSubjectID, wrappedKey)
+}
+
+func newTestWrapper(t *testing.T) *LocalKeyWrapper {
+	wrapper, err := NewLocalKeyWrapper(bytes.Repeat([]byte{1}, 32))
+	if err != nil {
+		t.Fatalf("Error creating key wrapper: %v", err)
+	}
+
+	return wrapper
+}
+
+func TestCrypter(t *testing.T) {
+	ctx := context.Background()
+	store := &countingKeyStore{KeyStore: keystore.NewMemory()}
+	wrapper := &countingKeyWrapper{KeyWrapper: newTestWrapper(t)}
+	c := New(store, wrapper)
+
+	ciphertext, err := c.Encrypt(ctx, "user:123", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	wrappedKey, err := store.Get(ctx, "user:123")
+	if err != nil {
+		t.Fatalf("Error getting wrapped key: %v", err)
+	}
+
+	if len(wrappedKey) == 0 {
+		t.Fatal("Expected wrapped key to be stored")
+	}
+
+	store.gets.Store(0)
+	wrapper.unwraps.Store(0)
+
+	for range 3 {
+		cleartext, err := c.Decrypt(ctx, "user:123", ciphertext)
+		if err != nil {
+			t.Fatalf("Error decrypting: %v", err)
+		}
+
+		if !bytes.Equal(cleartext, []byte("test")) {
+			t.Errorf("Expected cleartext %q, got %q", "test", cleartext)
+		}
+	}
+
+	if gets := store.gets.Load(); gets != 0 {
+		t.Errorf("Expected key to be cached, got %d key store reads", gets)
+	}
+
+	if unwraps := wrapper.unwraps.Load(); unwraps != 0 {
+		t.Errorf("Expected unwrapped key to be cached, got %d unwraps", unwraps)
+	}
+
+	tampered := bytes.Clone(ciphertext)
+	tampered[len(tampered)-1] ^= 1
+
+	if _, err := c.Decrypt(ctx, "user:123", tampered); err == nil {
+		t.Error("Expected error decrypting tampered ciphertext")
+	}
+
+	if err := store.Delete(ctx, "user:123"); err != nil {
+		t.Fatalf("Error deleting key: %v", err)
+	}
+
+	c.Invalidate("user:123")
+
+	if _, err := c.Decrypt(ctx, "user:123", ciphertext); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected ErrKeyShredded for deleted key, got %v", err)
+	}
+}
+
+func TestCrypterCacheTTL(t *testing.T) {
+	ctx := context.Background()
+	store := &countingKeyStore{KeyStore: keystore.NewMemory()}
+	c := New(store, newTestWrapper(t), WithCacheTTL(time.Millisecond))
+
+	ciphertext, err := c.Encrypt(ctx, "user:123", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if err := store.Delete(ctx, "user:123"); err != nil {
+		t.Fatalf("Error deleting key: %v", err)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+
+	if _, err := c.Decrypt(ctx, "user:123", ciphertext); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected ErrKeyShredded for deleted key after cache expiry, got %v", err)
+	}
+}
+
+// racingKeyStore is a key store that reads a key concurrently with the first deletion, as another decryption would.
+type racingKeyStore struct {
+	keystore.KeyStore
+	c     *Crypter
+	raced bool
+}
+
+func (s *racingKeyStore) Delete(ctx context.Context, id string) error {
+	if !s.raced {
+		s.raced = true
+		_, _ = s.c.Decrypt(ctx, id, nil)
+	}
+
+	return s.KeyStore.Delete(ctx, id)
+}
+
+func TestCrypterShredConcurrentLookup(t *testing.T) {
+	ctx := context.Background()
+	store := &racingKeyStore{KeyStore: keystore.NewMemory()}
+	c := New(store, newTestWrapper(t))
+	store.c = c
+
+	ciphertext, err := c.Encrypt(ctx, "user:123", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if err := c.Shred(ctx, "user:123"); err != nil {
+		t.Fatalf("Error shredding data subject: %v", err)
+	}
+
+	// A lookup while shredding must not leave a usable DEK in the cache
+	if _, err := c.Decrypt(ctx, "user:123", ciphertext); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected ErrKeyShredded for data subject looked up while shredding, got %v", err)
+	}
+}
+
+func TestCrypterShred(t *testing.T) {
+	ctx := context.Background()
+	c := New(keystore.NewMemory(), newTestWrapper(t))
+
+	ciphertext, err := c.Encrypt(ctx, "user:123", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	// Shredding must not leave a usable DEK in the cache
+	if err := c.Shred(ctx, "user:123"); err != nil {
+		t.Fatalf("Error shredding data subject: %v", err)
+	}
+
+	if _, err := c.Decrypt(ctx, "user:123", ciphertext); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected ErrKeyShredded for shredded data subject, got %v", err)
+	}
+
+	if shredded, err := c.IsShredded(ctx, "user:123"); err != nil || !shredded {
+		t.Errorf("Expected data subject to be shredded, got %t, %v", shredded, err)
+	}
+
+	ciphertext, err = c.Encrypt(ctx, "user:456", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if err := c.ShredPrefix(ctx, "user:"); err != nil {
+		t.Fatalf("Error shredding prefix: %v", err)
+	}
+
+	if _, err := c.Decrypt(ctx, "user:456", ciphertext); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected ErrKeyShredded for shredded prefix, got %v", err)
+	}
+}
+
+func TestCrypterWithPrivacy(t *testing.T) {
+	ctx := context.Background()
+	p := protoprivacy.New(New(keystore.NewMemory(), newTestWrapper(t)))
+
+	msg := testprotos.TestMessage_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("test"),
+	}.Build()
+
+	encrypted, err := p.Encrypt(ctx, msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	decrypted, err := p.Decrypt(ctx, encrypted)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if !proto.Equal(decrypted, msg) {
+		t.Errorf("Decrypted message does not match original message, expected %v, got %v", msg, decrypted)
+	}
+}
+
+func TestLocalKeyWrapper(t *testing.T) {
+	ctx := context.Background()
+	wrapper := newTestWrapper(t)
+
+	wrapped, err := wrapper.Wrap(ctx, "user:123", []byte("key"))
+	if err != nil {
+		t.Fatalf("Error wrapping key: %v", err)
+	}
+
+	key, err := wrapper.Unwrap(ctx, "user:123", wrapped)
+	if err != nil {
+		t.Fatalf("Error unwrapping key: %v", err)
+	}
+
+	if !bytes.Equal(key, []byte("key")) {
+		t.Errorf("Expected key %q, got %q", "key", key)
+	}
+
+	if _, err := wrapper.Unwrap(ctx, "user:456", wrapped); err == nil {
+		t.Error("Expected error unwrapping key for another data subject")
+	}
+
+	other, err := NewLocalKeyWrapper(bytes.Repeat([]byte{2}, 32))
+	if err != nil {
+		t.Fatalf("Error creating key wrapper: %v", err)
+	}
+
+	if _, err := other.Unwrap(ctx, "user:123", wrapped); err == nil {
+		t.Error("Expected error unwrapping key with another key encryption key")
+	}
+
+	if _, err := NewLocalKeyWrapper([]byte("short")); err == nil {
+		t.Error("Expected error creating key wrapper with invalid key encryption key")
+	}
+}
+
+func TestCrypterShredNotifier(t *testing.T) {
+	ctx := context.Background()
+	store := keystore.NewMemory()
+	notifier := protoprivacy.NewLocalShredNotifier()
+
+	replica1 := New(store, newTestWrapper(t), WithShredNotifier(notifier))
+	replica2 := New(store, newTestWrapper(t), WithShredNotifier(notifier))
+	defer replica1.Close()
+	defer replica2.Close()
+
+	ciphertext, err := replica1.Encrypt(ctx, "user:123", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	// Cache the wrapped DEK on the second replica
+	if _, err := replica2.Decrypt(ctx, "user:123", ciphertext); err != nil {
+		t.Fatalf("Error decrypting: %v", err)
+	}
+
+	if err := replica1.Shred(ctx, "user:123"); err != nil {
+		t.Fatalf("Error shredding data subject: %v", err)
+	}
+
+	if _, err := replica2.Decrypt(ctx, "user:123", ciphertext); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected other replica to evict shredded data subject, got %v", err)
+	}
+}
+
+func TestConformance(t *testing.T) {
+	crypttest.TestCrypter(t, func(t *testing.T) protoprivacy.Crypter {
+		return New(keystore.NewMemory(), newTestWrapper(t))
+	})
+}
@@ -0,0 +1,43 @@
+package dek
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Boostport/protoprivacy/internal/aead"
+)
+
+var errInvalidKEKSize = fmt.Errorf("key encryption key must be %d bytes", aead.KeySize)
+
+// KeyWrapper wraps and unwraps data encryption keys using a key encryption key, usually held in a key management
+// service. The data subject id should be bound to the wrapped key, so that a wrapped key cannot be used for another
+// data subject.
+type KeyWrapper interface {
+	Wrap(ctx context.Context, dataSubjectID string, key []byte) ([]byte, error)
+	Unwrap(ctx context.Context, dataSubjectID string, wrappedKey []byte) ([]byte, error)
+}
+
+// LocalKeyWrapper is a KeyWrapper that wraps keys in process using AES-256-GCM with a key encryption key held in
+// memory. It is intended for tests and development, and as a stand-in for a key management service.
+type LocalKeyWrapper struct {
+	kek []byte
+}
+
+// NewLocalKeyWrapper returns a LocalKeyWrapper that wraps keys using kek, which must be 32 bytes.
+func NewLocalKeyWrapper(kek []byte) (*LocalKeyWrapper, error) {
+	if len(kek) != aead.KeySize {
+		return nil, errInvalidKEKSize
+	}
+
+	return &LocalKeyWrapper{
+		kek: kek,
+	}, nil
+}
+
+func (w *LocalKeyWrapper) Wrap(_ context.Context, dataSubjectID string, key []byte) ([]byte, error) {
+	return aead.Seal(w.kek, key, []byte(dataSubjectID))
+}
+
+func (w *LocalKeyWrapper) Unwrap(_ context.Context, dataSubjectID string, wrappedKey []byte) ([]byte, error) {
+	return aead.Open(w.kek, wrappedKey, []byte(dataSubjectID))
+}
@@ -0,0 +1,218 @@
+// Package derivedkey provides a protoprivacy.Crypter that derives the key of each data subject from a master secret
+// using HKDF, so that only a small random salt has to be stored per data subject. Deleting the salt of a data subject
+// shreds its data.
+//
+// Data subject ids can start with a registered prefix, following the DataSubjectID.prefix convention. The key of a
+// prefixed data subject id is a sub-key derived from the key of the data subject id without the prefix and a salt of
+// its own. For example, with the "marketing:" prefix registered, data for "marketing:123" can be shredded on its own
+// by shredding "marketing:123", while shredding "123" shreds all data of the data subject.
+package derivedkey
+
+import (
+	"context"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/Boostport/protoprivacy"
+	"github.com/Boostport/protoprivacy/internal/aead"
+	"github.com/Boostport/protoprivacy/keycache"
+	"github.com/Boostport/protoprivacy/keystore"
+)
+
+const (
+	minMasterSecretSize = 32
+
+	// saltSize is the size of the random salt stored per data subject. The salt only has to make the keys of data
+	// subjects independent of each other, as the master secret provides the secrecy, and 16 bytes make collisions
+	// negligible while halving the storage per data subject compared to 32 bytes. Salts of other sizes that were stored
+	// earlier are still used as they are.
+	saltSize = 16
+
+	subjectKeyInfo = "protoprivacy subject key "
+	subKeyInfo     = "protoprivacy sub-key "
+)
+
+// Option configures a Crypter.
+type Option func(*Crypter)
+
+// WithPrefixes registers data subject id prefixes for which sub-keys are derived.
+func WithPrefixes(prefixes ...string) Option {
+	return func(c *Crypter) {
+		c.prefixes = append(c.prefixes, prefixes...)
+	}
+}
+
+// Crypter encrypts and decrypts data using AES-256-GCM with keys derived from a master secret. It implements
+// protoprivacy.Crypter.
+type Crypter struct {
+	masterSecret []byte
+	store        keystore.KeyStore
+	prefixes     []string
+}
+
+// New returns a Crypter that derives keys from masterSecret, which must be at least 32 bytes, and stores the salts of
+// data subjects in store. Salts are created when data is first encrypted for a data subject.
+func New(masterSecret []byte, store keystore.KeyStore, opts ...Option) (*Crypter, error) {
+	if len(masterSecret) < minMasterSecretSize {
+		return nil, fmt.Errorf("master secret must be at least %d bytes", minMasterSecretSize)
+	}
+
+	c := &Crypter{
+		masterSecret: masterSecret,
+		store:        store,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+func (c *Crypter) Encrypt(ctx context.Context, dataSubjectID string, cleartext []byte) ([]byte, error) {
+	handle, err := c.KeyHandle(ctx, dataSubjectID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return handle.Encrypt(ctx, cleartext)
+}
+
+func (c *Crypter) Decrypt(ctx context.Context, dataSubjectID string, ciphertext []byte) ([]byte, error) {
+	handle, err := c.KeyHandle(ctx, dataSubjectID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return handle.Decrypt(ctx, ciphertext)
+}
+
+// KeyHandle returns a key handle for the derived key of the data subject id, so the Crypter can be wrapped by a
+// keycache.Crypter. It implements keycache.KeyHandleProvider.
+func (c *Crypter) KeyHandle(ctx context.Context, dataSubjectID string, create bool) (keycache.KeyHandle, error) {
+	key, err := c.deriveKey(ctx, dataSubjectID, create)
+	if err != nil {
+		return nil, err
+	}
+
+	if key == nil {
+		return nil, protoprivacy.ErrKeyShredded
+	}
+
+	return aead.NewHandle(key, dataSubjectID), nil
+}
+
+// Shred deletes the salt of the data subject id, shredding its data. If the data subject id starts with a registered
+// prefix, only the data of the prefixed data subject id is shredded. It implements protoprivacy.Shredder.
+func (c *Crypter) Shred(ctx context.Context, dataSubjectID string) error {
+	if err := c.store.Delete(ctx, dataSubjectID); err != nil {
+		return fmt.Errorf("error deleting salt: %w", err)
+	}
+
+	return nil
+}
+
+// ShredPrefix deletes the salts of all data subject ids starting with the prefix. Shredding a registered prefix
+// shreds the data of that prefix for all data subjects.
+func (c *Crypter) ShredPrefix(ctx context.Context, prefix string) error {
+	if err := c.store.DeletePrefix(ctx, prefix); err != nil {
+		return fmt.Errorf("error deleting salts: %w", err)
+	}
+
+	return nil
+}
+
+// ShredSuffix deletes the salts of all data subject ids ending with the suffix. The key store must implement
+// keystore.SuffixDeleter.
+func (c *Crypter) ShredSuffix(ctx context.Context, suffix string) error {
+	if err := keystore.DeleteSuffix(ctx, c.store, suffix); err != nil {
+		return fmt.Errorf("error deleting salts: %w", err)
+	}
+
+	return nil
+}
+
+// IsShredded reports whether the key of the data subject id can no longer be derived, because its salt or the salt of
+// the data subject id without its prefix has been deleted.
+func (c *Crypter) IsShredded(ctx context.Context, dataSubjectID string) (bool, error) {
+	key, err := c.deriveKey(ctx, dataSubjectID, false)
+	if err != nil {
+		return false, err
+	}
+
+	return key == nil, nil
+}
+
+// deriveKey derives the key of the data subject id. If create is false and a salt does not exist, nil is returned as
+// the key.
+func (c *Crypter) deriveKey(ctx context.Context, dataSubjectID string, create bool) ([]byte, error) {
+	prefix := c.matchPrefix(dataSubjectID)
+	subjectID := strings.TrimPrefix(dataSubjectID, prefix)
+
+	salt, err := c.getSalt(ctx, subjectID, create)
+	if err != nil || salt == nil {
+		return nil, err
+	}
+
+	key, err := hkdf.Key(sha256.New, c.masterSecret, salt, subjectKeyInfo+subjectID, aead.KeySize)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving key: %w", err)
+	}
+
+	if prefix == "" {
+		return key, nil
+	}
+
+	subKeySalt, err := c.getSalt(ctx, dataSubjectID, create)
+	if err != nil || subKeySalt == nil {
+		return nil, err
+	}
+
+	key, err = hkdf.Key(sha256.New, key, subKeySalt, subKeyInfo+dataSubjectID, aead.KeySize)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving sub-key: %w", err)
+	}
+
+	return key, nil
+}
+
+// matchPrefix returns the longest registered prefix of the data subject id.
+func (c *Crypter) matchPrefix(dataSubjectID string) string {
+	var match string
+
+	for _, prefix := range c.prefixes {
+		if len(prefix) > len(match) && len(prefix) < len(dataSubjectID) && strings.HasPrefix(dataSubjectID, prefix) {
+			match = prefix
+		}
+	}
+
+	return match
+}
+
+func (c *Crypter) getSalt(ctx context.Context, id string, create bool) ([]byte, error) {
+	if !create {
+		salt, err := c.store.Get(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting salt: %w", err)
+		}
+
+		return salt, nil
+	}
+
+	salt, err := keystore.GetOrCreate(ctx, c.store, id, func() ([]byte, error) {
+		salt := make([]byte, saltSize)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, fmt.Errorf("error generating salt: %w", err)
+		}
+
+		return salt, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting or creating salt: %w", err)
+	}
+
+	return salt, nil
+}
@@ -0,0 +1,183 @@
+package derivedkey
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Boostport/protoprivacy"
+	"github.com/Boostport/protoprivacy/crypttest"
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"github.com/Boostport/protoprivacy/keystore"
+	"google.golang.org/protobuf/proto"
+)
+
+var testMasterSecret = bytes.Repeat([]byte{1}, 32)
+
+func newTestCrypter(t *testing.T, store keystore.KeyStore, opts ...Option) *Crypter {
+	c, err := New(testMasterSecret, store, opts...)
+	if err != nil {
+		t.Fatalf("Error creating crypter: %v", err)
+	}
+
+	return c
+}
+
+func TestCrypter(t *testing.T) {
+	ctx := context.Background()
+	store := keystore.NewMemory()
+	c := newTestCrypter(t, store)
+
+	first, err := c.Encrypt(ctx, "123", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	second, err := c.Encrypt(ctx, "123", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if bytes.Equal(first, second) {
+		t.Error("Expected distinct ciphertexts for identical cleartexts")
+	}
+
+	salt, err := store.Get(ctx, "123")
+	if err != nil {
+		t.Fatalf("Error getting salt: %v", err)
+	}
+
+	if len(salt) != saltSize {
+		t.Errorf("Expected only a %d byte salt to be stored, got %d bytes", saltSize, len(salt))
+	}
+
+	// A new crypter with the same master secret and store derives the same key
+	cleartext, err := newTestCrypter(t, store).Decrypt(ctx, "123", first)
+	if err != nil {
+		t.Fatalf("Error decrypting: %v", err)
+	}
+
+	if !bytes.Equal(cleartext, []byte("test")) {
+		t.Errorf("Expected cleartext %q, got %q", "test", cleartext)
+	}
+
+	tampered := bytes.Clone(first)
+	tampered[len(tampered)-1] ^= 1
+
+	if _, err := c.Decrypt(ctx, "123", tampered); err == nil {
+		t.Error("Expected error decrypting tampered ciphertext")
+	}
+
+	other, err := New(bytes.Repeat([]byte{2}, 32), store)
+	if err != nil {
+		t.Fatalf("Error creating crypter: %v", err)
+	}
+
+	if cleartext, err := other.Decrypt(ctx, "123", first); err == nil && cleartext != nil {
+		t.Error("Expected decrypting with another master secret to fail")
+	}
+
+	if err := c.Shred(ctx, "123"); err != nil {
+		t.Fatalf("Error deleting salt: %v", err)
+	}
+
+	if _, err := c.Decrypt(ctx, "123", first); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected ErrKeyShredded for deleted salt, got %v", err)
+	}
+
+	if _, err := c.Encrypt(ctx, "123", []byte("test")); err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if _, err := c.Decrypt(ctx, "123", first); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected ErrKeyShredded for replaced salt, got %v", err)
+	}
+}
+
+func TestCrypterPrefixes(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCrypter(t, keystore.NewMemory(), WithPrefixes("marketing:", "billing:"))
+
+	encrypt := func(dataSubjectID string) []byte {
+		ciphertext, err := c.Encrypt(ctx, dataSubjectID, []byte("test"))
+		if err != nil {
+			t.Fatalf("Error encrypting: %v", err)
+		}
+		return ciphertext
+	}
+
+	isShredded := func(dataSubjectID string, ciphertext []byte) bool {
+		_, err := c.Decrypt(ctx, dataSubjectID, ciphertext)
+		if err != nil && !errors.Is(err, protoprivacy.ErrKeyShredded) {
+			t.Fatalf("Error decrypting: %v", err)
+		}
+		return err != nil
+	}
+
+	marketing := encrypt("marketing:123")
+	billing := encrypt("billing:123")
+	unprefixed := encrypt("123")
+
+	if err := c.Shred(ctx, "marketing:123"); err != nil {
+		t.Fatalf("Error deleting salt: %v", err)
+	}
+
+	if !isShredded("marketing:123", marketing) {
+		t.Error("Expected data of deleted prefix to be shredded")
+	}
+
+	if isShredded("billing:123", billing) || isShredded("123", unprefixed) {
+		t.Error("Expected data of other prefixes to remain readable")
+	}
+
+	if err := c.Shred(ctx, "123"); err != nil {
+		t.Fatalf("Error deleting salt: %v", err)
+	}
+
+	if !isShredded("billing:123", billing) || !isShredded("123", unprefixed) {
+		t.Error("Expected all data of the data subject to be shredded")
+	}
+
+	for _, id := range []string{"marketing:123", "billing:123", "123"} {
+		if shredded, err := c.IsShredded(ctx, id); err != nil || !shredded {
+			t.Errorf("Expected %s to be shredded, got %t, %v", id, shredded, err)
+		}
+	}
+}
+
+func TestCrypterWithPrivacy(t *testing.T) {
+	ctx := context.Background()
+	p := protoprivacy.New(newTestCrypter(t, keystore.NewMemory(), WithPrefixes("user:")))
+
+	msg := testprotos.TestKeyBucket_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("test"),
+	}.Build()
+
+	encrypted, err := p.Encrypt(ctx, msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	decrypted, err := p.Decrypt(ctx, encrypted)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if !proto.Equal(decrypted, msg) {
+		t.Errorf("Decrypted message does not match original message, expected %v, got %v", msg, decrypted)
+	}
+}
+
+func TestNewShortMasterSecret(t *testing.T) {
+	if _, err := New([]byte("short"), keystore.NewMemory()); err == nil {
+		t.Error("Expected error creating crypter with short master secret")
+	}
+}
+
+func TestConformance(t *testing.T) {
+	crypttest.TestCrypter(t, func(t *testing.T) protoprivacy.Crypter {
+		return newTestCrypter(t, keystore.NewMemory(), WithPrefixes("crypttest-"))
+	})
+}
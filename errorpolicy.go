@@ -0,0 +1,166 @@
+package protoprivacy
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	defaultMaxRetries     = 3
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 5 * time.Second
+)
+
+// ErrorClass classifies errors returned by crypters.
+type ErrorClass int
+
+const (
+	// ErrorClassPermanent is an error that will happen again if the operation is retried, such as a misconfigured
+	// crypter.
+	ErrorClassPermanent ErrorClass = iota
+
+	// ErrorClassTransient is an error that may not happen again if the operation is retried, such as a timeout while
+	// calling a key management service.
+	ErrorClassTransient
+)
+
+// ErrorClassifier can be implemented by crypters to classify the errors they return. Crypters that do not implement it
+// have their errors classified as transient if they are context.DeadlineExceeded or have a Timeout or Temporary method
+// returning true, and as permanent otherwise.
+type ErrorClassifier interface {
+	ClassifyError(err error) ErrorClass
+}
+
+// ErrorAction is the action taken when a crypter fails to decrypt a message.
+type ErrorAction int
+
+const (
+	// ErrorActionReturn returns the error from Decrypt.
+	ErrorActionReturn ErrorAction = iota
+
+	// ErrorActionDegrade clears the personal data fields or sets them to their fallback values, as if the data subject
+	// had been shredded, and reports the outcome as DecryptOutcomeDegraded.
+	ErrorActionDegrade
+
+	// ErrorActionRetry retries decrypting with exponential backoff. If all retries fail, the error is returned.
+	ErrorActionRetry
+)
+
+// ErrorPolicy decides what Decrypt does when the crypter fails, depending on the class of the error. Errors wrapping
+// ErrCiphertextCorrupt are always permanent, and errors caused by the context being canceled are always returned. The
+// zero value returns all errors.
+type ErrorPolicy struct {
+	// Transient is the action taken for transient errors.
+	Transient ErrorAction
+
+	// Permanent is the action taken for permanent errors.
+	Permanent ErrorAction
+
+	// MaxRetries is the maximum number of retries for errors with the ErrorActionRetry action. The default is 3.
+	MaxRetries int
+
+	// InitialBackoff is the delay before the first retry, which doubles for each further retry. The default is 100ms.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between retries. The default is 5s.
+	MaxBackoff time.Duration
+}
+
+// WithErrorPolicy sets the policy for errors returned by the crypter when decrypting.
+func WithErrorPolicy(policy ErrorPolicy) Option {
+	return func(p *Privacy) {
+		p.errorPolicy = policy
+	}
+}
+
+// decrypt decrypts data using the crypter, applying the error policy to errors, and records the outcome in the report.
+func (p *Privacy) decrypt(ctx context.Context, scope string, data []byte, report *DecryptReport) ([]byte, error) {
+	backoff := p.errorPolicy.InitialBackoff
+	if backoff <= 0 {
+		backoff = defaultInitialBackoff
+	}
+
+	maxBackoff := p.errorPolicy.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+
+	maxRetries := p.errorPolicy.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = defaultMaxRetries
+	}
+
+	ctx = withReencryptSignal(ctx, report)
+
+	for attempt := 0; ; attempt++ {
+		cleartext, err := p.crypter.Decrypt(ctx, scope, data)
+
+		report.Outcome, err = decryptOutcome(cleartext, err)
+		if err == nil {
+			return cleartext, nil
+		}
+
+		switch p.errorAction(ctx, err) {
+		case ErrorActionDegrade:
+			report.Outcome = DecryptOutcomeDegraded
+			report.DegradedError = err
+			return nil, nil
+		case ErrorActionRetry:
+			if attempt >= maxRetries {
+				return nil, err
+			}
+
+			timer := time.NewTimer(backoff)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, errors.Join(err, ctx.Err())
+			case <-timer.C:
+			}
+
+			backoff = min(2*backoff, maxBackoff)
+		default:
+			return nil, err
+		}
+	}
+}
+
+func (p *Privacy) errorAction(ctx context.Context, err error) ErrorAction {
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) {
+		return ErrorActionReturn
+	}
+
+	if p.classifyError(err) == ErrorClassTransient {
+		return p.errorPolicy.Transient
+	}
+
+	return p.errorPolicy.Permanent
+}
+
+func (p *Privacy) classifyError(err error) ErrorClass {
+	if errors.Is(err, ErrCiphertextCorrupt) {
+		return ErrorClassPermanent
+	}
+
+	if classifier, ok := p.crypter.(ErrorClassifier); ok {
+		return classifier.ClassifyError(err)
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrorClassTransient
+	}
+
+	var timeout interface{ Timeout() bool }
+	if errors.As(err, &timeout) && timeout.Timeout() {
+		return ErrorClassTransient
+	}
+
+	var temporary interface{ Temporary() bool }
+	if errors.As(err, &temporary) && temporary.Temporary() {
+		return ErrorClassTransient
+	}
+
+	return ErrorClassPermanent
+}
@@ -0,0 +1,217 @@
+package protoprivacy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string { return "timeout" }
+func (timeoutError) Timeout() bool { return true }
+
+var errUnavailable = errors.New("key service unavailable")
+
+// flakyCrypter fails to decrypt with err for the first failures calls.
+type flakyCrypter struct {
+	fakeCrypter
+	err          error
+	failures     int
+	decryptCalls int
+}
+
+func (c *flakyCrypter) Decrypt(ctx context.Context, dataSubjectID string, ciphertext []byte) ([]byte, error) {
+	c.decryptCalls++
+	if c.decryptCalls <= c.failures {
+		return nil, c.err
+	}
+
+	return c.fakeCrypter.Decrypt(ctx, dataSubjectID, ciphertext)
+}
+
+// classifyingCrypter is a flakyCrypter that classifies errUnavailable as transient.
+type classifyingCrypter struct {
+	flakyCrypter
+}
+
+func (c *classifyingCrypter) ClassifyError(err error) ErrorClass {
+	if errors.Is(err, errUnavailable) {
+		return ErrorClassTransient
+	}
+
+	return ErrorClassPermanent
+}
+
+func TestErrorPolicy(t *testing.T) {
+	msg := testprotos.TestFallbackTypes_builder{
+		Id:     proto.String("123"),
+		Data14: proto.String("secret"),
+	}.Build()
+
+	degraded := testprotos.TestFallbackTypes_builder{
+		Id:     proto.String("123"),
+		Data14: proto.String("test"),
+	}.Build()
+
+	degradeTransient := ErrorPolicy{
+		Transient: ErrorActionDegrade,
+	}
+
+	retryTransient := ErrorPolicy{
+		Transient:      ErrorActionRetry,
+		MaxRetries:     2,
+		InitialBackoff: time.Millisecond,
+	}
+
+	for _, tt := range []struct {
+		explanation     string
+		crypter         Crypter
+		policy          ErrorPolicy
+		expected        proto.Message
+		expectedOutcome DecryptOutcome
+		expectedErr     error
+	}{
+		{
+			explanation: "Default policy returns error",
+			crypter:     &flakyCrypter{err: timeoutError{}, failures: 1},
+			expectedErr: timeoutError{},
+		},
+		{
+			explanation:     "Transient error degraded",
+			crypter:         &flakyCrypter{err: fmt.Errorf("calling kms: %w", timeoutError{}), failures: 1},
+			policy:          degradeTransient,
+			expected:        degraded,
+			expectedOutcome: DecryptOutcomeDegraded,
+		},
+		{
+			explanation: "Permanent error returned",
+			crypter:     &flakyCrypter{err: errUnavailable, failures: 1},
+			policy:      degradeTransient,
+			expectedErr: errUnavailable,
+		},
+		{
+			explanation:     "Error classified as transient by crypter degraded",
+			crypter:         &classifyingCrypter{flakyCrypter{err: errUnavailable, failures: 1}},
+			policy:          degradeTransient,
+			expected:        degraded,
+			expectedOutcome: DecryptOutcomeDegraded,
+		},
+		{
+			explanation: "Corrupt ciphertext is always permanent",
+			crypter:     &classifyingCrypter{flakyCrypter{err: fmt.Errorf("%w: %w", ErrCiphertextCorrupt, errUnavailable), failures: 1}},
+			policy:      degradeTransient,
+			expectedErr: ErrCiphertextCorrupt,
+		},
+		{
+			explanation:     "Transient error retried",
+			crypter:         &flakyCrypter{err: context.DeadlineExceeded, failures: 2},
+			policy:          retryTransient,
+			expected:        msg,
+			expectedOutcome: DecryptOutcomeDecrypted,
+		},
+		{
+			explanation: "Retries exhausted",
+			crypter:     &flakyCrypter{err: context.DeadlineExceeded, failures: 3},
+			policy:      retryTransient,
+			expectedErr: context.DeadlineExceeded,
+		},
+		{
+			explanation:     "Transient error retried by default",
+			crypter:         &flakyCrypter{err: context.DeadlineExceeded, failures: 3},
+			policy:          ErrorPolicy{Transient: ErrorActionRetry, InitialBackoff: time.Millisecond},
+			expected:        msg,
+			expectedOutcome: DecryptOutcomeDecrypted,
+		},
+		{
+			explanation: "Default retries exhausted",
+			crypter:     &flakyCrypter{err: context.DeadlineExceeded, failures: 4},
+			policy:      ErrorPolicy{Transient: ErrorActionRetry, InitialBackoff: time.Millisecond},
+			expectedErr: context.DeadlineExceeded,
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			p := New(tt.crypter, WithErrorPolicy(tt.policy))
+
+			envelope, err := p.Encrypt(context.Background(), msg)
+			if err != nil {
+				t.Fatalf("Error encrypting message: %v", err)
+			}
+
+			decrypted, report, err := p.DecryptWithReport(context.Background(), envelope)
+
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("Expected error %v, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Error decrypting message: %v", err)
+			}
+
+			if report.Outcome != tt.expectedOutcome {
+				t.Errorf("Expected outcome %s, got %s", tt.expectedOutcome, report.Outcome)
+			}
+
+			if tt.expectedOutcome == DecryptOutcomeDegraded && report.DegradedError == nil {
+				t.Error("Expected degraded report to contain the crypter error")
+			}
+
+			if !proto.Equal(decrypted, tt.expected) {
+				t.Errorf("Decrypted message does not match expected message, expected %v, got %v", tt.expected, decrypted)
+			}
+		})
+	}
+}
+
+func TestErrorPolicyRetryCanceled(t *testing.T) {
+	crypter := &flakyCrypter{err: context.DeadlineExceeded, failures: 10}
+	p := New(crypter, WithErrorPolicy(ErrorPolicy{
+		Transient:      ErrorActionRetry,
+		MaxRetries:     10,
+		InitialBackoff: time.Hour,
+	}))
+
+	envelope, err := p.Encrypt(context.Background(), testprotos.TestMessage_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("test"),
+	}.Build())
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := p.Decrypt(ctx, envelope); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context error, got %v", err)
+	}
+
+	if crypter.decryptCalls != 1 {
+		t.Errorf("Expected 1 call to the crypter before the context expired, got %d", crypter.decryptCalls)
+	}
+}
+
+func TestReencryptDegraded(t *testing.T) {
+	crypter := &flakyCrypter{err: timeoutError{}, failures: 1}
+	p := New(crypter, WithErrorPolicy(ErrorPolicy{Transient: ErrorActionDegrade}))
+
+	envelope, err := p.Encrypt(context.Background(), testprotos.TestMessage_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("test"),
+	}.Build())
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	if _, err := p.Reencrypt(context.Background(), envelope); !errors.Is(err, timeoutError{}) {
+		t.Errorf("Expected re-encrypting to return the crypter error instead of degrading, got %v", err)
+	}
+}
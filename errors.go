@@ -0,0 +1,41 @@
+package protoprivacy
+
+import (
+	"errors"
+
+	"github.com/Boostport/protoprivacy/internal/sentinel"
+)
+
+// Errors returned by crypters from Decrypt to signal why data could not be decrypted. Crypters can wrap them to add
+// context, so they should be checked using errors.Is.
+var (
+	// ErrKeyShredded signals that the key of the data subject has been deleted. Privacy.Decrypt clears the personal
+	// data fields or sets them to their fallback values. Returning nil as the cleartext and nil as the error
+	// (nil, nil) is treated the same way for compatibility with older crypters.
+	ErrKeyShredded = errors.New("key has been shredded")
+
+	// ErrKeyNotFound signals that a key for the data subject could not be found, for example because it never existed
+	// or was deleted without a trace. Privacy.Decrypt treats the data the same way as shredded data.
+	ErrKeyNotFound = errors.New("key not found")
+
+	// ErrCiphertextCorrupt signals that the ciphertext is malformed or failed authentication. Privacy.Decrypt returns
+	// an error wrapping it.
+	ErrCiphertextCorrupt = sentinel.ErrCiphertextCorrupt
+)
+
+// decryptOutcome returns the outcome of decrypting data using a crypter given the cleartext and error it returned. An
+// error is only returned if the data could not be decrypted for a reason other than the key being unavailable.
+func decryptOutcome(cleartext []byte, err error) (DecryptOutcome, error) {
+	switch {
+	case errors.Is(err, ErrKeyShredded):
+		return DecryptOutcomeKeyShredded, nil
+	case errors.Is(err, ErrKeyNotFound):
+		return DecryptOutcomeKeyNotFound, nil
+	case err != nil:
+		return DecryptOutcomeNotEncrypted, err
+	case cleartext == nil:
+		return DecryptOutcomeKeyShredded, nil
+	}
+
+	return DecryptOutcomeDecrypted, nil
+}
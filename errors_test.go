@@ -0,0 +1,119 @@
+package protoprivacy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakeErrorCrypter struct {
+	fakeCrypter
+	err error
+}
+
+func (c fakeErrorCrypter) Decrypt(ctx context.Context, dataSubjectID string, ciphertext []byte) ([]byte, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return c.fakeCrypter.Decrypt(ctx, dataSubjectID, ciphertext)
+}
+
+func TestDecryptOutcome(t *testing.T) {
+	msg := testprotos.TestFallbackTypes_builder{
+		Id:     proto.String("123"),
+		Data14: proto.String("secret"),
+	}.Build()
+
+	shredded := testprotos.TestFallbackTypes_builder{
+		Id:     proto.String("123"),
+		Data14: proto.String("test"),
+	}.Build()
+
+	for _, tt := range []struct {
+		explanation     string
+		crypter         Crypter
+		expected        proto.Message
+		expectedOutcome DecryptOutcome
+		expectedErr     error
+	}{
+		{
+			explanation:     "Decrypted",
+			crypter:         fakeCrypter{},
+			expected:        msg,
+			expectedOutcome: DecryptOutcomeDecrypted,
+		},
+		{
+			explanation:     "Legacy shredded",
+			crypter:         fakeDeletedDataSubjectCrypter{},
+			expected:        shredded,
+			expectedOutcome: DecryptOutcomeKeyShredded,
+		},
+		{
+			explanation:     "Key shredded",
+			crypter:         fakeErrorCrypter{err: fmt.Errorf("key deleted: %w", ErrKeyShredded)},
+			expected:        shredded,
+			expectedOutcome: DecryptOutcomeKeyShredded,
+		},
+		{
+			explanation:     "Key not found",
+			crypter:         fakeErrorCrypter{err: ErrKeyNotFound},
+			expected:        shredded,
+			expectedOutcome: DecryptOutcomeKeyNotFound,
+		},
+		{
+			explanation: "Ciphertext corrupt",
+			crypter:     fakeErrorCrypter{err: fmt.Errorf("bad tag: %w", ErrCiphertextCorrupt)},
+			expectedErr: ErrCiphertextCorrupt,
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			p := New(tt.crypter)
+
+			envelope, err := p.Encrypt(context.Background(), msg)
+			if err != nil {
+				t.Fatalf("Error encrypting message: %v", err)
+			}
+
+			decrypted, report, err := p.DecryptWithReport(context.Background(), envelope)
+
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("Expected error %v, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Error decrypting message: %v", err)
+			}
+
+			if report.Outcome != tt.expectedOutcome {
+				t.Errorf("Expected outcome %s, got %s", tt.expectedOutcome, report.Outcome)
+			}
+
+			if !proto.Equal(decrypted, tt.expected) {
+				t.Errorf("Decrypted message does not match expected message, expected %v, got %v", tt.expected, decrypted)
+			}
+		})
+	}
+}
+
+func TestDecryptOutcomeNotEncrypted(t *testing.T) {
+	msg := testprotos.TestMessage_builder{
+		Id: proto.String("123"),
+	}.Build()
+
+	_, report, err := New(fakeCrypter{}).DecryptWithReport(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if report.Outcome != DecryptOutcomeNotEncrypted {
+		t.Errorf("Expected outcome %s, got %s", DecryptOutcomeNotEncrypted, report.Outcome)
+	}
+}
@@ -0,0 +1,292 @@
+// Package frame implements a self-describing framing format for ciphertexts, so that the algorithm and key a ciphertext
+// was encrypted with can be determined without holding any keys. The crypters in this module frame their ciphertexts
+// using it, and other crypters can use it to interoperate with tooling that inspects envelopes.
+//
+// Framed ciphertexts have the following layout:
+//
+//	magic (2 bytes) | version (1 byte) | algorithm (1 byte) | key id length (1 byte) | key id | nonce length (1 byte) |
+//	nonce | payload
+//
+// The payload is the encrypted data produced by the algorithm. Crypters should authenticate the header, which is
+// everything preceding the payload, as additional data.
+//
+// Frames with AlgorithmKeyVersioned wrap the ciphertext of the crypter of a key version, such as those written by
+// protoprivacy.RotatingCrypter. The key version is stored in the key id, so that it can be inspected like any other
+// key id, and the payload is the wrapped ciphertext, which is usually framed itself.
+package frame
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	"github.com/Boostport/protoprivacy/internal/sentinel"
+	"google.golang.org/protobuf/proto"
+)
+
+// Version is the version of the framing format written by Append.
+const Version = 1
+
+const maxFieldSize = 255
+
+var magic = []byte{0xb0, 0x57}
+
+// ErrNotFramed is returned by Parse if the data does not start with the magic bytes of the framing format. It wraps
+// protoprivacy.ErrCiphertextCorrupt.
+var ErrNotFramed = fmt.Errorf("%w: ciphertext is not framed", sentinel.ErrCiphertextCorrupt)
+
+// Algorithm identifies the algorithm a framed ciphertext was encrypted with.
+type Algorithm uint8
+
+const (
+	// AlgorithmUnspecified means the algorithm is not specified.
+	AlgorithmUnspecified Algorithm = iota
+
+	// AlgorithmAES256GCM means the payload was encrypted using AES-256-GCM.
+	AlgorithmAES256GCM
+
+	// AlgorithmKeyVersioned means the payload is the ciphertext of the crypter of the key version stored in the key
+	// id. Use KeyVersion to read it.
+	AlgorithmKeyVersioned
+)
+
+func (a Algorithm) String() string {
+	switch a {
+	case AlgorithmUnspecified:
+		return "unspecified"
+	case AlgorithmAES256GCM:
+		return "AES-256-GCM"
+	case AlgorithmKeyVersioned:
+		return "key-versioned"
+	}
+
+	return fmt.Sprintf("unknown (%d)", uint8(a))
+}
+
+// Frame is a framed ciphertext.
+type Frame struct {
+	// Version is the version of the framing format.
+	Version uint8
+
+	// Algorithm is the algorithm the payload was encrypted with.
+	Algorithm Algorithm
+
+	// KeyID identifies the key the payload was encrypted with. It must not reveal the key and must be at most 255
+	// bytes.
+	KeyID []byte
+
+	// Nonce is the nonce the payload was encrypted with. It must be at most 255 bytes.
+	Nonce []byte
+
+	// Payload is the encrypted data.
+	Payload []byte
+}
+
+// AppendHeader appends the header of the frame, which is everything preceding the payload, to dst using the current
+// version of the framing format and returns the extended buffer.
+func (f *Frame) AppendHeader(dst []byte) ([]byte, error) {
+	if len(f.KeyID) > maxFieldSize {
+		return nil, fmt.Errorf("key id must be at most %d bytes", maxFieldSize)
+	}
+
+	if len(f.Nonce) > maxFieldSize {
+		return nil, fmt.Errorf("nonce must be at most %d bytes", maxFieldSize)
+	}
+
+	dst = append(dst, magic...)
+	dst = append(dst, Version, byte(f.Algorithm), byte(len(f.KeyID)))
+	dst = append(dst, f.KeyID...)
+	dst = append(dst, byte(len(f.Nonce)))
+	dst = append(dst, f.Nonce...)
+
+	return dst, nil
+}
+
+// Append appends the frame, including its payload, to dst and returns the extended buffer.
+func (f *Frame) Append(dst []byte) ([]byte, error) {
+	dst, err := f.AppendHeader(dst)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(dst, f.Payload...), nil
+}
+
+// NewKeyVersioned returns a frame with AlgorithmKeyVersioned wrapping the ciphertext of the crypter of the key version.
+func NewKeyVersioned(version uint64, ciphertext []byte) *Frame {
+	return &Frame{
+		Algorithm: AlgorithmKeyVersioned,
+		KeyID:     binary.AppendUvarint(nil, version),
+		Payload:   ciphertext,
+	}
+}
+
+// KeyVersion returns the key version of a frame with AlgorithmKeyVersioned. An error wrapping
+// protoprivacy.ErrCiphertextCorrupt is returned if the frame has another algorithm or its key id is not a key version.
+func (f *Frame) KeyVersion() (uint64, error) {
+	if f.Algorithm != AlgorithmKeyVersioned {
+		return 0, fmt.Errorf("%w: algorithm %s does not have a key version", sentinel.ErrCiphertextCorrupt, f.Algorithm)
+	}
+
+	version, n := binary.Uvarint(f.KeyID)
+	if n <= 0 || n != len(f.KeyID) {
+		return 0, fmt.Errorf("%w: invalid key version", sentinel.ErrCiphertextCorrupt)
+	}
+
+	return version, nil
+}
+
+// HeaderSize returns the size of the header of the frame.
+func (f *Frame) HeaderSize() int {
+	return len(magic) + 4 + len(f.KeyID) + len(f.Nonce)
+}
+
+// IsFramed reports whether data starts with the magic bytes of the framing format.
+func IsFramed(data []byte) bool {
+	return bytes.HasPrefix(data, magic)
+}
+
+// Parse parses a framed ciphertext. The key id, nonce and payload of the returned frame share memory with data. An
+// error wrapping protoprivacy.ErrCiphertextCorrupt is returned if data is not a valid framed ciphertext.
+func Parse(data []byte) (*Frame, error) {
+	if !IsFramed(data) {
+		return nil, ErrNotFramed
+	}
+
+	rest := data[len(magic):]
+	if len(rest) < 3 {
+		return nil, fmt.Errorf("%w: header too short", sentinel.ErrCiphertextCorrupt)
+	}
+
+	f := &Frame{
+		Version:   rest[0],
+		Algorithm: Algorithm(rest[1]),
+	}
+
+	if f.Version != Version {
+		return nil, fmt.Errorf("%w: unsupported frame version %d", sentinel.ErrCiphertextCorrupt, f.Version)
+	}
+
+	var err error
+
+	if f.KeyID, rest, err = readField(rest[2:]); err != nil {
+		return nil, fmt.Errorf("%w: error reading key id: %w", sentinel.ErrCiphertextCorrupt, err)
+	}
+
+	if f.Nonce, rest, err = readField(rest); err != nil {
+		return nil, fmt.Errorf("%w: error reading nonce: %w", sentinel.ErrCiphertextCorrupt, err)
+	}
+
+	f.Payload = rest
+
+	return f, nil
+}
+
+// readField reads a field prefixed with its length and returns it and the remaining data.
+func readField(data []byte) ([]byte, []byte, error) {
+	if len(data) < 1 {
+		return nil, nil, errors.New("missing length")
+	}
+
+	size := int(data[0])
+	if len(data) < 1+size {
+		return nil, nil, errors.New("data too short")
+	}
+
+	return data[1 : 1+size], data[1+size:], nil
+}
+
+// Info describes the framed encrypted data of an envelope without decrypting it.
+type Info struct {
+	// Version is the version of the framing format.
+	Version uint8
+
+	// Algorithm is the algorithm the data was encrypted with. It is AlgorithmUnspecified if the data was wrapped in a
+	// frame with a key version but is not framed itself.
+	Algorithm Algorithm
+
+	// KeyID identifies the key the data was encrypted with.
+	KeyID []byte
+
+	// KeyVersion is the key version the data was encrypted under if HasKeyVersion is true.
+	KeyVersion uint64
+
+	// HasKeyVersion reports whether the data was wrapped in a frame with a key version, such as by
+	// protoprivacy.RotatingCrypter.
+	HasKeyVersion bool
+
+	// KeyBucket is the key bucket of the envelope, if any.
+	KeyBucket string
+
+	// Compression is the name of the compressor the data was compressed with before it was encrypted, if any.
+	Compression string
+
+	// PayloadSize is the size of the encrypted payload in bytes.
+	PayloadSize int
+}
+
+func (i Info) String() string {
+	s := fmt.Sprintf("version=%d algorithm=%s key_id=%s payload_size=%d", i.Version, i.Algorithm, hex.EncodeToString(i.KeyID), i.PayloadSize)
+
+	if i.HasKeyVersion {
+		s += fmt.Sprintf(" key_version=%d", i.KeyVersion)
+	}
+
+	if i.KeyBucket != "" {
+		s += " key_bucket=" + i.KeyBucket
+	}
+
+	if i.Compression != "" {
+		s += " compression=" + i.Compression
+	}
+
+	return s
+}
+
+// Inspect returns information about the encrypted data of an envelope returned by protoprivacy.Privacy.Encrypt. It
+// does not require any keys. If the data is wrapped in a frame with a key version, the key version is reported along
+// with the wrapped frame. ErrNotFramed is returned if the data was encrypted by a crypter that does not use the framing
+// format.
+func Inspect(envelope proto.Message) (Info, error) {
+	e, ok := envelope.(*privacy.Envelope)
+	if !ok {
+		return Info{}, errors.New("message is not an envelope")
+	}
+
+	f, err := Parse(e.GetEncryptedData())
+	if err != nil {
+		return Info{}, err
+	}
+
+	info := Info{
+		Version:     f.Version,
+		KeyBucket:   e.GetKeyBucket(),
+		Compression: e.GetCompression(),
+	}
+
+	if f.Algorithm == AlgorithmKeyVersioned {
+		if info.KeyVersion, err = f.KeyVersion(); err != nil {
+			return Info{}, err
+		}
+
+		info.HasKeyVersion = true
+
+		if !IsFramed(f.Payload) {
+			info.PayloadSize = len(f.Payload)
+			return info, nil
+		}
+
+		if f, err = Parse(f.Payload); err != nil {
+			return Info{}, err
+		}
+	}
+
+	info.Algorithm = f.Algorithm
+	info.KeyID = f.KeyID
+	info.PayloadSize = len(f.Payload)
+
+	return info, nil
+}
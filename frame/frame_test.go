@@ -0,0 +1,209 @@
+package frame
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"github.com/Boostport/protoprivacy/internal/sentinel"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFrame(t *testing.T) {
+	f := &Frame{
+		Algorithm: AlgorithmAES256GCM,
+		KeyID:     []byte("key"),
+		Nonce:     []byte("nonce"),
+		Payload:   []byte("payload"),
+	}
+
+	data, err := f.Append([]byte("prefix"))
+	if err != nil {
+		t.Fatalf("Error appending frame: %v", err)
+	}
+
+	if !bytes.HasPrefix(data, []byte("prefix")) {
+		t.Errorf("Expected frame to be appended to prefix, got %q", data)
+	}
+
+	data = data[len("prefix"):]
+
+	if len(data) != f.HeaderSize()+len(f.Payload) {
+		t.Errorf("Expected frame size %d, got %d", f.HeaderSize()+len(f.Payload), len(data))
+	}
+
+	if !IsFramed(data) {
+		t.Error("Expected data to be framed")
+	}
+
+	parsed, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Error parsing frame: %v", err)
+	}
+
+	if parsed.Version != Version || parsed.Algorithm != f.Algorithm || !bytes.Equal(parsed.KeyID, f.KeyID) ||
+		!bytes.Equal(parsed.Nonce, f.Nonce) || !bytes.Equal(parsed.Payload, f.Payload) {
+		t.Errorf("Parsed frame does not match original frame, expected %+v, got %+v", f, parsed)
+	}
+}
+
+func TestFrameFieldTooLarge(t *testing.T) {
+	if _, err := (&Frame{KeyID: make([]byte, 256)}).Append(nil); err == nil {
+		t.Error("Expected error appending frame with key id larger than 255 bytes")
+	}
+
+	if _, err := (&Frame{Nonce: make([]byte, 256)}).Append(nil); err == nil {
+		t.Error("Expected error appending frame with nonce larger than 255 bytes")
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	valid, err := (&Frame{Algorithm: AlgorithmAES256GCM, KeyID: []byte("key"), Nonce: []byte("nonce")}).Append(nil)
+	if err != nil {
+		t.Fatalf("Error appending frame: %v", err)
+	}
+
+	unsupportedVersion := bytes.Clone(valid)
+	unsupportedVersion[len(magic)] = Version + 1
+
+	for _, tt := range []struct {
+		explanation string
+		data        []byte
+		notFramed   bool
+	}{
+		{
+			explanation: "Empty",
+			data:        nil,
+			notFramed:   true,
+		},
+		{
+			explanation: "Missing magic",
+			data:        []byte("not framed"),
+			notFramed:   true,
+		},
+		{
+			explanation: "Truncated header",
+			data:        valid[:len(magic)+2],
+		},
+		{
+			explanation: "Truncated key id",
+			data:        valid[:len(magic)+4],
+		},
+		{
+			explanation: "Truncated nonce",
+			data:        valid[:len(valid)-1],
+		},
+		{
+			explanation: "Unsupported version",
+			data:        unsupportedVersion,
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			_, err := Parse(tt.data)
+			if !errors.Is(err, sentinel.ErrCiphertextCorrupt) {
+				t.Errorf("Expected ErrCiphertextCorrupt, got %v", err)
+			}
+
+			if errors.Is(err, ErrNotFramed) != tt.notFramed {
+				t.Errorf("Expected ErrNotFramed to be %t, got %v", tt.notFramed, err)
+			}
+		})
+	}
+}
+
+func TestInspect(t *testing.T) {
+	data, err := (&Frame{Algorithm: AlgorithmAES256GCM, KeyID: []byte{0xab, 0xcd}, Nonce: []byte("nonce"), Payload: []byte("payload")}).Append(nil)
+	if err != nil {
+		t.Fatalf("Error appending frame: %v", err)
+	}
+
+	envelope := privacy.Envelope_builder{
+		EncryptedData: data,
+		KeyBucket:     proto.String("2025-01"),
+		Compression:   proto.String("gzip"),
+	}.Build()
+
+	info, err := Inspect(envelope)
+	if err != nil {
+		t.Fatalf("Error inspecting envelope: %v", err)
+	}
+
+	expected := "version=1 algorithm=AES-256-GCM key_id=abcd payload_size=7 key_bucket=2025-01 compression=gzip"
+	if info.String() != expected {
+		t.Errorf("Expected info %q, got %q", expected, info.String())
+	}
+
+	if _, err := Inspect(testprotos.TestMessage_builder{Id: proto.String("123")}.Build()); err == nil {
+		t.Error("Expected error inspecting message that is not an envelope")
+	}
+
+	if _, err := Inspect(privacy.Envelope_builder{EncryptedData: []byte("not framed")}.Build()); !errors.Is(err, ErrNotFramed) {
+		t.Errorf("Expected ErrNotFramed, got %v", err)
+	}
+}
+
+func TestInspectKeyVersioned(t *testing.T) {
+	inner, err := (&Frame{Algorithm: AlgorithmAES256GCM, KeyID: []byte{0xab, 0xcd}, Nonce: []byte("nonce"), Payload: []byte("payload")}).Append(nil)
+	if err != nil {
+		t.Fatalf("Error appending frame: %v", err)
+	}
+
+	for _, tt := range []struct {
+		explanation string
+		ciphertext  []byte
+		expected    string
+	}{
+		{
+			explanation: "Framed ciphertext",
+			ciphertext:  inner,
+			expected:    "version=1 algorithm=AES-256-GCM key_id=abcd payload_size=7 key_version=300",
+		},
+		{
+			explanation: "Ciphertext that is not framed",
+			ciphertext:  []byte("not framed"),
+			expected:    "version=1 algorithm=unspecified key_id= payload_size=10 key_version=300",
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			data, err := NewKeyVersioned(300, tt.ciphertext).Append(nil)
+			if err != nil {
+				t.Fatalf("Error appending frame: %v", err)
+			}
+
+			info, err := Inspect(privacy.Envelope_builder{EncryptedData: data}.Build())
+			if err != nil {
+				t.Fatalf("Error inspecting envelope: %v", err)
+			}
+
+			if info.String() != tt.expected {
+				t.Errorf("Expected info %q, got %q", tt.expected, info.String())
+			}
+		})
+	}
+}
+
+func TestKeyVersion(t *testing.T) {
+	data, err := NewKeyVersioned(7, []byte("ciphertext")).Append(nil)
+	if err != nil {
+		t.Fatalf("Error appending frame: %v", err)
+	}
+
+	f, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Error parsing frame: %v", err)
+	}
+
+	if version, err := f.KeyVersion(); err != nil || version != 7 {
+		t.Errorf("Expected key version 7, got %d, %v", version, err)
+	}
+
+	if _, err := (&Frame{Algorithm: AlgorithmAES256GCM, KeyID: []byte{7}}).KeyVersion(); !errors.Is(err, sentinel.ErrCiphertextCorrupt) {
+		t.Errorf("Expected ErrCiphertextCorrupt for frame without key version, got %v", err)
+	}
+
+	if _, err := (&Frame{Algorithm: AlgorithmKeyVersioned, KeyID: []byte{0x80}}).KeyVersion(); !errors.Is(err, sentinel.ErrCiphertextCorrupt) {
+		t.Errorf("Expected ErrCiphertextCorrupt for invalid key version, got %v", err)
+	}
+}
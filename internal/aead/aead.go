@@ -0,0 +1,164 @@
+// Package aead implements the AES-256-GCM ciphertexts shared by the crypters in this module.
+//
+// Ciphertexts are framed using the frame package with a key id derived from the key, so that data encrypted with a key
+// that has since been deleted and replaced can be told apart from corrupt data. The frame header is authenticated
+// along with the additional data.
+package aead
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/Boostport/protoprivacy"
+	"github.com/Boostport/protoprivacy/frame"
+)
+
+const (
+	// KeySize is the size of the keys used to encrypt data.
+	KeySize = 32
+
+	keyIDSize = 8
+	nonceSize = 12
+)
+
+// HeaderSize is the size of the frame header preceding the sealed data.
+var HeaderSize = (&frame.Frame{KeyID: make([]byte, keyIDSize), Nonce: make([]byte, nonceSize)}).HeaderSize()
+
+// ErrKeyMismatch is returned by Open if the ciphertext was encrypted with a different key.
+var ErrKeyMismatch = errors.New("ciphertext was encrypted with a different key")
+
+// NewKey returns a new random key.
+func NewKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("error generating key: %w", err)
+	}
+
+	return key, nil
+}
+
+// Seal encrypts and authenticates cleartext and authenticates additionalData.
+func Seal(key []byte, cleartext []byte, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &frame.Frame{
+		Algorithm: frame.AlgorithmAES256GCM,
+		KeyID:     keyID(key),
+		Nonce:     make([]byte, nonceSize),
+	}
+
+	if _, err := rand.Read(f.Nonce); err != nil {
+		return nil, fmt.Errorf("error generating nonce: %w", err)
+	}
+
+	ciphertext, err := f.AppendHeader(make([]byte, 0, HeaderSize+len(cleartext)+aead.Overhead()))
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(ciphertext, f.Nonce, cleartext, authenticatedData(ciphertext, additionalData)), nil
+}
+
+// Open decrypts and authenticates ciphertext and authenticates additionalData. If the ciphertext was encrypted with a
+// different key, ErrKeyMismatch is returned.
+func Open(key []byte, ciphertext []byte, additionalData []byte) ([]byte, error) {
+	f, err := frame.Parse(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.Algorithm != frame.AlgorithmAES256GCM {
+		return nil, fmt.Errorf("%w: unsupported algorithm %s", protoprivacy.ErrCiphertextCorrupt, f.Algorithm)
+	}
+
+	if len(f.Nonce) != nonceSize {
+		return nil, fmt.Errorf("%w: invalid nonce size %d", protoprivacy.ErrCiphertextCorrupt, len(f.Nonce))
+	}
+
+	if !bytes.Equal(keyID(key), f.KeyID) {
+		return nil, ErrKeyMismatch
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := ciphertext[:f.HeaderSize()]
+
+	// Open into a non-nil slice, so that an empty cleartext is not mistaken for a shredded key
+	cleartext := make([]byte, 0, len(f.Payload))
+
+	cleartext, err = aead.Open(cleartext, f.Nonce, f.Payload, authenticatedData(header, additionalData))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", protoprivacy.ErrCiphertextCorrupt, err)
+	}
+
+	return cleartext, nil
+}
+
+// authenticatedData returns the frame header followed by the additional data.
+func authenticatedData(header []byte, additionalData []byte) []byte {
+	return append(bytes.Clone(header), additionalData...)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("invalid key size %d", len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("error creating gcm: %w", err)
+	}
+
+	return aead, nil
+}
+
+// keyID returns a short identifier for the key that does not reveal the key.
+func keyID(key []byte) []byte {
+	sum := sha256.Sum256(append([]byte("protoprivacy key id"), key...))
+	return sum[:keyIDSize]
+}
+
+// Handle encrypts and decrypts data for a data subject using its key. Ciphertexts encrypted with a different key are
+// reported as protoprivacy.ErrKeyShredded, as the key they were encrypted with has been deleted and replaced.
+type Handle struct {
+	key           []byte
+	dataSubjectID []byte
+}
+
+// NewHandle returns a Handle that encrypts data for the data subject id using key.
+func NewHandle(key []byte, dataSubjectID string) *Handle {
+	return &Handle{
+		key:           key,
+		dataSubjectID: []byte(dataSubjectID),
+	}
+}
+
+func (h *Handle) Encrypt(_ context.Context, cleartext []byte) ([]byte, error) {
+	return Seal(h.key, cleartext, h.dataSubjectID)
+}
+
+func (h *Handle) Decrypt(_ context.Context, ciphertext []byte) ([]byte, error) {
+	cleartext, err := Open(h.key, ciphertext, h.dataSubjectID)
+	if errors.Is(err, ErrKeyMismatch) {
+		return nil, fmt.Errorf("%w: %w", protoprivacy.ErrKeyShredded, err)
+	}
+
+	return cleartext, err
+}
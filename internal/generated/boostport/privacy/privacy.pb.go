@@ -11,6 +11,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	unsafe "unsafe"
 )
@@ -22,10 +23,210 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DataCategory int32
+
+const (
+	DataCategory_DATA_CATEGORY_UNSPECIFIED DataCategory = 0
+	DataCategory_DATA_CATEGORY_CONTACT     DataCategory = 1
+	DataCategory_DATA_CATEGORY_IDENTITY    DataCategory = 2
+	DataCategory_DATA_CATEGORY_DEMOGRAPHIC DataCategory = 3
+	DataCategory_DATA_CATEGORY_LOCATION    DataCategory = 4
+	DataCategory_DATA_CATEGORY_FINANCIAL   DataCategory = 5
+	DataCategory_DATA_CATEGORY_HEALTH      DataCategory = 6
+	DataCategory_DATA_CATEGORY_BIOMETRIC   DataCategory = 7
+	DataCategory_DATA_CATEGORY_GENETIC     DataCategory = 8
+)
+
+// Enum value maps for DataCategory.
+var (
+	DataCategory_name = map[int32]string{
+		0: "DATA_CATEGORY_UNSPECIFIED",
+		1: "DATA_CATEGORY_CONTACT",
+		2: "DATA_CATEGORY_IDENTITY",
+		3: "DATA_CATEGORY_DEMOGRAPHIC",
+		4: "DATA_CATEGORY_LOCATION",
+		5: "DATA_CATEGORY_FINANCIAL",
+		6: "DATA_CATEGORY_HEALTH",
+		7: "DATA_CATEGORY_BIOMETRIC",
+		8: "DATA_CATEGORY_GENETIC",
+	}
+	DataCategory_value = map[string]int32{
+		"DATA_CATEGORY_UNSPECIFIED": 0,
+		"DATA_CATEGORY_CONTACT":     1,
+		"DATA_CATEGORY_IDENTITY":    2,
+		"DATA_CATEGORY_DEMOGRAPHIC": 3,
+		"DATA_CATEGORY_LOCATION":    4,
+		"DATA_CATEGORY_FINANCIAL":   5,
+		"DATA_CATEGORY_HEALTH":      6,
+		"DATA_CATEGORY_BIOMETRIC":   7,
+		"DATA_CATEGORY_GENETIC":     8,
+	}
+)
+
+func (x DataCategory) Enum() *DataCategory {
+	p := new(DataCategory)
+	*p = x
+	return p
+}
+
+func (x DataCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_boostport_privacy_privacy_proto_enumTypes[0].Descriptor()
+}
+
+func (DataCategory) Type() protoreflect.EnumType {
+	return &file_boostport_privacy_privacy_proto_enumTypes[0]
+}
+
+func (x DataCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type PrivacyFieldOptions_DataSubjectID_KeyBucket int32
+
+const (
+	PrivacyFieldOptions_DataSubjectID_KEY_BUCKET_UNSPECIFIED PrivacyFieldOptions_DataSubjectID_KeyBucket = 0
+	PrivacyFieldOptions_DataSubjectID_KEY_BUCKET_MONTH       PrivacyFieldOptions_DataSubjectID_KeyBucket = 1
+	PrivacyFieldOptions_DataSubjectID_KEY_BUCKET_QUARTER     PrivacyFieldOptions_DataSubjectID_KeyBucket = 2
+)
+
+// Enum value maps for PrivacyFieldOptions_DataSubjectID_KeyBucket.
+var (
+	PrivacyFieldOptions_DataSubjectID_KeyBucket_name = map[int32]string{
+		0: "KEY_BUCKET_UNSPECIFIED",
+		1: "KEY_BUCKET_MONTH",
+		2: "KEY_BUCKET_QUARTER",
+	}
+	PrivacyFieldOptions_DataSubjectID_KeyBucket_value = map[string]int32{
+		"KEY_BUCKET_UNSPECIFIED": 0,
+		"KEY_BUCKET_MONTH":       1,
+		"KEY_BUCKET_QUARTER":     2,
+	}
+)
+
+func (x PrivacyFieldOptions_DataSubjectID_KeyBucket) Enum() *PrivacyFieldOptions_DataSubjectID_KeyBucket {
+	p := new(PrivacyFieldOptions_DataSubjectID_KeyBucket)
+	*p = x
+	return p
+}
+
+func (x PrivacyFieldOptions_DataSubjectID_KeyBucket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrivacyFieldOptions_DataSubjectID_KeyBucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_boostport_privacy_privacy_proto_enumTypes[1].Descriptor()
+}
+
+func (PrivacyFieldOptions_DataSubjectID_KeyBucket) Type() protoreflect.EnumType {
+	return &file_boostport_privacy_privacy_proto_enumTypes[1]
+}
+
+func (x PrivacyFieldOptions_DataSubjectID_KeyBucket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type PrivacyFieldOptions_Pseudonymize_Scope int32
+
+const (
+	PrivacyFieldOptions_Pseudonymize_SCOPE_UNSPECIFIED  PrivacyFieldOptions_Pseudonymize_Scope = 0
+	PrivacyFieldOptions_Pseudonymize_SCOPE_GLOBAL       PrivacyFieldOptions_Pseudonymize_Scope = 1
+	PrivacyFieldOptions_Pseudonymize_SCOPE_DATA_SUBJECT PrivacyFieldOptions_Pseudonymize_Scope = 2
+)
+
+// Enum value maps for PrivacyFieldOptions_Pseudonymize_Scope.
+var (
+	PrivacyFieldOptions_Pseudonymize_Scope_name = map[int32]string{
+		0: "SCOPE_UNSPECIFIED",
+		1: "SCOPE_GLOBAL",
+		2: "SCOPE_DATA_SUBJECT",
+	}
+	PrivacyFieldOptions_Pseudonymize_Scope_value = map[string]int32{
+		"SCOPE_UNSPECIFIED":  0,
+		"SCOPE_GLOBAL":       1,
+		"SCOPE_DATA_SUBJECT": 2,
+	}
+)
+
+func (x PrivacyFieldOptions_Pseudonymize_Scope) Enum() *PrivacyFieldOptions_Pseudonymize_Scope {
+	p := new(PrivacyFieldOptions_Pseudonymize_Scope)
+	*p = x
+	return p
+}
+
+func (x PrivacyFieldOptions_Pseudonymize_Scope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrivacyFieldOptions_Pseudonymize_Scope) Descriptor() protoreflect.EnumDescriptor {
+	return file_boostport_privacy_privacy_proto_enumTypes[2].Descriptor()
+}
+
+func (PrivacyFieldOptions_Pseudonymize_Scope) Type() protoreflect.EnumType {
+	return &file_boostport_privacy_privacy_proto_enumTypes[2]
+}
+
+func (x PrivacyFieldOptions_Pseudonymize_Scope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type PrivacyFieldOptions_BlindIndex_Normalization int32
+
+const (
+	PrivacyFieldOptions_BlindIndex_NORMALIZATION_UNSPECIFIED PrivacyFieldOptions_BlindIndex_Normalization = 0
+	PrivacyFieldOptions_BlindIndex_NORMALIZATION_NONE        PrivacyFieldOptions_BlindIndex_Normalization = 1
+	PrivacyFieldOptions_BlindIndex_NORMALIZATION_LOWERCASE   PrivacyFieldOptions_BlindIndex_Normalization = 2
+	PrivacyFieldOptions_BlindIndex_NORMALIZATION_DIGITS      PrivacyFieldOptions_BlindIndex_Normalization = 3
+)
+
+// Enum value maps for PrivacyFieldOptions_BlindIndex_Normalization.
+var (
+	PrivacyFieldOptions_BlindIndex_Normalization_name = map[int32]string{
+		0: "NORMALIZATION_UNSPECIFIED",
+		1: "NORMALIZATION_NONE",
+		2: "NORMALIZATION_LOWERCASE",
+		3: "NORMALIZATION_DIGITS",
+	}
+	PrivacyFieldOptions_BlindIndex_Normalization_value = map[string]int32{
+		"NORMALIZATION_UNSPECIFIED": 0,
+		"NORMALIZATION_NONE":        1,
+		"NORMALIZATION_LOWERCASE":   2,
+		"NORMALIZATION_DIGITS":      3,
+	}
+)
+
+func (x PrivacyFieldOptions_BlindIndex_Normalization) Enum() *PrivacyFieldOptions_BlindIndex_Normalization {
+	p := new(PrivacyFieldOptions_BlindIndex_Normalization)
+	*p = x
+	return p
+}
+
+func (x PrivacyFieldOptions_BlindIndex_Normalization) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrivacyFieldOptions_BlindIndex_Normalization) Descriptor() protoreflect.EnumDescriptor {
+	return file_boostport_privacy_privacy_proto_enumTypes[3].Descriptor()
+}
+
+func (PrivacyFieldOptions_BlindIndex_Normalization) Type() protoreflect.EnumType {
+	return &file_boostport_privacy_privacy_proto_enumTypes[3]
+}
+
+func (x PrivacyFieldOptions_BlindIndex_Normalization) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 type Envelope struct {
 	state                    protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Message       *anypb.Any             `protobuf:"bytes,1,opt,name=message"`
 	xxx_hidden_EncryptedData []byte                 `protobuf:"bytes,2,opt,name=encrypted_data,json=encryptedData"`
+	xxx_hidden_BlindIndexes  map[string]string      `protobuf:"bytes,3,rep,name=blind_indexes,json=blindIndexes" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_KeyBucket     *string                `protobuf:"bytes,4,opt,name=key_bucket,json=keyBucket"`
+	xxx_hidden_Compression   *string                `protobuf:"bytes,5,opt,name=compression"`
 	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
 	XXX_presence             [1]uint32
 	unknownFields            protoimpl.UnknownFields
@@ -71,6 +272,33 @@ func (x *Envelope) GetEncryptedData() []byte {
 	return nil
 }
 
+func (x *Envelope) GetBlindIndexes() map[string]string {
+	if x != nil {
+		return x.xxx_hidden_BlindIndexes
+	}
+	return nil
+}
+
+func (x *Envelope) GetKeyBucket() string {
+	if x != nil {
+		if x.xxx_hidden_KeyBucket != nil {
+			return *x.xxx_hidden_KeyBucket
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Envelope) GetCompression() string {
+	if x != nil {
+		if x.xxx_hidden_Compression != nil {
+			return *x.xxx_hidden_Compression
+		}
+		return ""
+	}
+	return ""
+}
+
 func (x *Envelope) SetMessage(v *anypb.Any) {
 	x.xxx_hidden_Message = v
 }
@@ -80,7 +308,21 @@ func (x *Envelope) SetEncryptedData(v []byte) {
 		v = []byte{}
 	}
 	x.xxx_hidden_EncryptedData = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *Envelope) SetBlindIndexes(v map[string]string) {
+	x.xxx_hidden_BlindIndexes = v
+}
+
+func (x *Envelope) SetKeyBucket(v string) {
+	x.xxx_hidden_KeyBucket = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *Envelope) SetCompression(v string) {
+	x.xxx_hidden_Compression = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
 }
 
 func (x *Envelope) HasMessage() bool {
@@ -97,6 +339,20 @@ func (x *Envelope) HasEncryptedData() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
+func (x *Envelope) HasKeyBucket() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Envelope) HasCompression() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
 func (x *Envelope) ClearMessage() {
 	x.xxx_hidden_Message = nil
 }
@@ -106,11 +362,24 @@ func (x *Envelope) ClearEncryptedData() {
 	x.xxx_hidden_EncryptedData = nil
 }
 
+func (x *Envelope) ClearKeyBucket() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_KeyBucket = nil
+}
+
+func (x *Envelope) ClearCompression() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Compression = nil
+}
+
 type Envelope_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	Message       *anypb.Any
 	EncryptedData []byte
+	BlindIndexes  map[string]string
+	KeyBucket     *string
+	Compression   *string
 }
 
 func (b0 Envelope_builder) Build() *Envelope {
@@ -119,9 +388,96 @@ func (b0 Envelope_builder) Build() *Envelope {
 	_, _ = b, x
 	x.xxx_hidden_Message = b.Message
 	if b.EncryptedData != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
 		x.xxx_hidden_EncryptedData = b.EncryptedData
 	}
+	x.xxx_hidden_BlindIndexes = b.BlindIndexes
+	if b.KeyBucket != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_KeyBucket = b.KeyBucket
+	}
+	if b.Compression != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_Compression = b.Compression
+	}
+	return m0
+}
+
+type PrivacyMessageOptions struct {
+	state                              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RetentionTimestampField *string                `protobuf:"bytes,1,opt,name=retention_timestamp_field,json=retentionTimestampField"`
+	XXX_raceDetectHookData             protoimpl.RaceDetectHookData
+	XXX_presence                       [1]uint32
+	unknownFields                      protoimpl.UnknownFields
+	sizeCache                          protoimpl.SizeCache
+}
+
+func (x *PrivacyMessageOptions) Reset() {
+	*x = PrivacyMessageOptions{}
+	mi := &file_boostport_privacy_privacy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacyMessageOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacyMessageOptions) ProtoMessage() {}
+
+func (x *PrivacyMessageOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_privacy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PrivacyMessageOptions) GetRetentionTimestampField() string {
+	if x != nil {
+		if x.xxx_hidden_RetentionTimestampField != nil {
+			return *x.xxx_hidden_RetentionTimestampField
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *PrivacyMessageOptions) SetRetentionTimestampField(v string) {
+	x.xxx_hidden_RetentionTimestampField = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *PrivacyMessageOptions) HasRetentionTimestampField() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *PrivacyMessageOptions) ClearRetentionTimestampField() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_RetentionTimestampField = nil
+}
+
+type PrivacyMessageOptions_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	RetentionTimestampField *string
+}
+
+func (b0 PrivacyMessageOptions_builder) Build() *PrivacyMessageOptions {
+	m0 := &PrivacyMessageOptions{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.RetentionTimestampField != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_RetentionTimestampField = b.RetentionTimestampField
+	}
 	return m0
 }
 
@@ -134,7 +490,7 @@ type PrivacyFieldOptions struct {
 
 func (x *PrivacyFieldOptions) Reset() {
 	*x = PrivacyFieldOptions{}
-	mi := &file_boostport_privacy_privacy_proto_msgTypes[1]
+	mi := &file_boostport_privacy_privacy_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -146,7 +502,7 @@ func (x *PrivacyFieldOptions) String() string {
 func (*PrivacyFieldOptions) ProtoMessage() {}
 
 func (x *PrivacyFieldOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_boostport_privacy_privacy_proto_msgTypes[1]
+	mi := &file_boostport_privacy_privacy_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -273,7 +629,7 @@ func (b0 PrivacyFieldOptions_builder) Build() *PrivacyFieldOptions {
 type case_PrivacyFieldOptions_Type protoreflect.FieldNumber
 
 func (x case_PrivacyFieldOptions_Type) String() string {
-	md := file_boostport_privacy_privacy_proto_msgTypes[1].Descriptor()
+	md := file_boostport_privacy_privacy_proto_msgTypes[2].Descriptor()
 	if x == 0 {
 		return "not set"
 	}
@@ -297,17 +653,19 @@ func (*privacyFieldOptions_DataSubjectId) isPrivacyFieldOptions_Type() {}
 func (*privacyFieldOptions_PersonalData_) isPrivacyFieldOptions_Type() {}
 
 type PrivacyFieldOptions_DataSubjectID struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Prefix      *string                `protobuf:"bytes,1,opt,name=prefix"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	state                              protoimpl.MessageState                      `protogen:"opaque.v1"`
+	xxx_hidden_Prefix                  *string                                     `protobuf:"bytes,1,opt,name=prefix"`
+	xxx_hidden_KeyBucket               PrivacyFieldOptions_DataSubjectID_KeyBucket `protobuf:"varint,2,opt,name=key_bucket,json=keyBucket,enum=boostport.privacy.PrivacyFieldOptions_DataSubjectID_KeyBucket"`
+	xxx_hidden_KeyBucketTimestampField *string                                     `protobuf:"bytes,3,opt,name=key_bucket_timestamp_field,json=keyBucketTimestampField"`
+	XXX_raceDetectHookData             protoimpl.RaceDetectHookData
+	XXX_presence                       [1]uint32
+	unknownFields                      protoimpl.UnknownFields
+	sizeCache                          protoimpl.SizeCache
 }
 
 func (x *PrivacyFieldOptions_DataSubjectID) Reset() {
 	*x = PrivacyFieldOptions_DataSubjectID{}
-	mi := &file_boostport_privacy_privacy_proto_msgTypes[2]
+	mi := &file_boostport_privacy_privacy_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -319,7 +677,7 @@ func (x *PrivacyFieldOptions_DataSubjectID) String() string {
 func (*PrivacyFieldOptions_DataSubjectID) ProtoMessage() {}
 
 func (x *PrivacyFieldOptions_DataSubjectID) ProtoReflect() protoreflect.Message {
-	mi := &file_boostport_privacy_privacy_proto_msgTypes[2]
+	mi := &file_boostport_privacy_privacy_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,9 +698,38 @@ func (x *PrivacyFieldOptions_DataSubjectID) GetPrefix() string {
 	return ""
 }
 
+func (x *PrivacyFieldOptions_DataSubjectID) GetKeyBucket() PrivacyFieldOptions_DataSubjectID_KeyBucket {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_KeyBucket
+		}
+	}
+	return PrivacyFieldOptions_DataSubjectID_KEY_BUCKET_UNSPECIFIED
+}
+
+func (x *PrivacyFieldOptions_DataSubjectID) GetKeyBucketTimestampField() string {
+	if x != nil {
+		if x.xxx_hidden_KeyBucketTimestampField != nil {
+			return *x.xxx_hidden_KeyBucketTimestampField
+		}
+		return ""
+	}
+	return ""
+}
+
 func (x *PrivacyFieldOptions_DataSubjectID) SetPrefix(v string) {
 	x.xxx_hidden_Prefix = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *PrivacyFieldOptions_DataSubjectID) SetKeyBucket(v PrivacyFieldOptions_DataSubjectID_KeyBucket) {
+	x.xxx_hidden_KeyBucket = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *PrivacyFieldOptions_DataSubjectID) SetKeyBucketTimestampField(v string) {
+	x.xxx_hidden_KeyBucketTimestampField = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
 }
 
 func (x *PrivacyFieldOptions_DataSubjectID) HasPrefix() bool {
@@ -352,15 +739,41 @@ func (x *PrivacyFieldOptions_DataSubjectID) HasPrefix() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
+func (x *PrivacyFieldOptions_DataSubjectID) HasKeyBucket() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *PrivacyFieldOptions_DataSubjectID) HasKeyBucketTimestampField() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
 func (x *PrivacyFieldOptions_DataSubjectID) ClearPrefix() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Prefix = nil
 }
 
+func (x *PrivacyFieldOptions_DataSubjectID) ClearKeyBucket() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_KeyBucket = PrivacyFieldOptions_DataSubjectID_KEY_BUCKET_UNSPECIFIED
+}
+
+func (x *PrivacyFieldOptions_DataSubjectID) ClearKeyBucketTimestampField() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_KeyBucketTimestampField = nil
+}
+
 type PrivacyFieldOptions_DataSubjectID_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Prefix *string
+	Prefix                  *string
+	KeyBucket               *PrivacyFieldOptions_DataSubjectID_KeyBucket
+	KeyBucketTimestampField *string
 }
 
 func (b0 PrivacyFieldOptions_DataSubjectID_builder) Build() *PrivacyFieldOptions_DataSubjectID {
@@ -368,22 +781,39 @@ func (b0 PrivacyFieldOptions_DataSubjectID_builder) Build() *PrivacyFieldOptions
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Prefix != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
 		x.xxx_hidden_Prefix = b.Prefix
 	}
+	if b.KeyBucket != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_KeyBucket = *b.KeyBucket
+	}
+	if b.KeyBucketTimestampField != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_KeyBucketTimestampField = b.KeyBucketTimestampField
+	}
 	return m0
 }
 
 type PrivacyFieldOptions_PersonalData struct {
-	state               protoimpl.MessageState                      `protogen:"opaque.v1"`
-	xxx_hidden_Fallback isPrivacyFieldOptions_PersonalData_Fallback `protobuf_oneof:"fallback"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	state                     protoimpl.MessageState                      `protogen:"opaque.v1"`
+	xxx_hidden_Fallback       isPrivacyFieldOptions_PersonalData_Fallback `protobuf_oneof:"fallback"`
+	xxx_hidden_Mask           *PrivacyFieldOptions_Mask                   `protobuf:"bytes,16,opt,name=mask"`
+	xxx_hidden_Pseudonymize   *PrivacyFieldOptions_Pseudonymize           `protobuf:"bytes,17,opt,name=pseudonymize"`
+	xxx_hidden_BlindIndex     *PrivacyFieldOptions_BlindIndex             `protobuf:"bytes,18,opt,name=blind_index,json=blindIndex"`
+	xxx_hidden_Category       DataCategory                                `protobuf:"varint,19,opt,name=category,enum=boostport.privacy.DataCategory"`
+	xxx_hidden_CustomCategory *string                                     `protobuf:"bytes,20,opt,name=custom_category,json=customCategory"`
+	xxx_hidden_Condition      *string                                     `protobuf:"bytes,21,opt,name=condition"`
+	xxx_hidden_Retention      *durationpb.Duration                        `protobuf:"bytes,22,opt,name=retention"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *PrivacyFieldOptions_PersonalData) Reset() {
 	*x = PrivacyFieldOptions_PersonalData{}
-	mi := &file_boostport_privacy_privacy_proto_msgTypes[3]
+	mi := &file_boostport_privacy_privacy_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -395,7 +825,7 @@ func (x *PrivacyFieldOptions_PersonalData) String() string {
 func (*PrivacyFieldOptions_PersonalData) ProtoMessage() {}
 
 func (x *PrivacyFieldOptions_PersonalData) ProtoReflect() protoreflect.Message {
-	mi := &file_boostport_privacy_privacy_proto_msgTypes[3]
+	mi := &file_boostport_privacy_privacy_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,6 +971,63 @@ func (x *PrivacyFieldOptions_PersonalData) GetFallbackBytes() []byte {
 	return nil
 }
 
+func (x *PrivacyFieldOptions_PersonalData) GetMask() *PrivacyFieldOptions_Mask {
+	if x != nil {
+		return x.xxx_hidden_Mask
+	}
+	return nil
+}
+
+func (x *PrivacyFieldOptions_PersonalData) GetPseudonymize() *PrivacyFieldOptions_Pseudonymize {
+	if x != nil {
+		return x.xxx_hidden_Pseudonymize
+	}
+	return nil
+}
+
+func (x *PrivacyFieldOptions_PersonalData) GetBlindIndex() *PrivacyFieldOptions_BlindIndex {
+	if x != nil {
+		return x.xxx_hidden_BlindIndex
+	}
+	return nil
+}
+
+func (x *PrivacyFieldOptions_PersonalData) GetCategory() DataCategory {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 4) {
+			return x.xxx_hidden_Category
+		}
+	}
+	return DataCategory_DATA_CATEGORY_UNSPECIFIED
+}
+
+func (x *PrivacyFieldOptions_PersonalData) GetCustomCategory() string {
+	if x != nil {
+		if x.xxx_hidden_CustomCategory != nil {
+			return *x.xxx_hidden_CustomCategory
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *PrivacyFieldOptions_PersonalData) GetCondition() string {
+	if x != nil {
+		if x.xxx_hidden_Condition != nil {
+			return *x.xxx_hidden_Condition
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *PrivacyFieldOptions_PersonalData) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_Retention
+	}
+	return nil
+}
+
 func (x *PrivacyFieldOptions_PersonalData) SetFallbackDouble(v float64) {
 	x.xxx_hidden_Fallback = &privacyFieldOptions_PersonalData_FallbackDouble{v}
 }
@@ -604,8 +1091,39 @@ func (x *PrivacyFieldOptions_PersonalData) SetFallbackBytes(v []byte) {
 	x.xxx_hidden_Fallback = &privacyFieldOptions_PersonalData_FallbackBytes{v}
 }
 
-func (x *PrivacyFieldOptions_PersonalData) HasFallback() bool {
-	if x == nil {
+func (x *PrivacyFieldOptions_PersonalData) SetMask(v *PrivacyFieldOptions_Mask) {
+	x.xxx_hidden_Mask = v
+}
+
+func (x *PrivacyFieldOptions_PersonalData) SetPseudonymize(v *PrivacyFieldOptions_Pseudonymize) {
+	x.xxx_hidden_Pseudonymize = v
+}
+
+func (x *PrivacyFieldOptions_PersonalData) SetBlindIndex(v *PrivacyFieldOptions_BlindIndex) {
+	x.xxx_hidden_BlindIndex = v
+}
+
+func (x *PrivacyFieldOptions_PersonalData) SetCategory(v DataCategory) {
+	x.xxx_hidden_Category = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
+}
+
+func (x *PrivacyFieldOptions_PersonalData) SetCustomCategory(v string) {
+	x.xxx_hidden_CustomCategory = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+}
+
+func (x *PrivacyFieldOptions_PersonalData) SetCondition(v string) {
+	x.xxx_hidden_Condition = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *PrivacyFieldOptions_PersonalData) SetRetention(v *durationpb.Duration) {
+	x.xxx_hidden_Retention = v
+}
+
+func (x *PrivacyFieldOptions_PersonalData) HasFallback() bool {
+	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_Fallback != nil
@@ -731,6 +1249,55 @@ func (x *PrivacyFieldOptions_PersonalData) HasFallbackBytes() bool {
 	return ok
 }
 
+func (x *PrivacyFieldOptions_PersonalData) HasMask() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Mask != nil
+}
+
+func (x *PrivacyFieldOptions_PersonalData) HasPseudonymize() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Pseudonymize != nil
+}
+
+func (x *PrivacyFieldOptions_PersonalData) HasBlindIndex() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_BlindIndex != nil
+}
+
+func (x *PrivacyFieldOptions_PersonalData) HasCategory() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *PrivacyFieldOptions_PersonalData) HasCustomCategory() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *PrivacyFieldOptions_PersonalData) HasCondition() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *PrivacyFieldOptions_PersonalData) HasRetention() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Retention != nil
+}
+
 func (x *PrivacyFieldOptions_PersonalData) ClearFallback() {
 	x.xxx_hidden_Fallback = nil
 }
@@ -825,6 +1392,37 @@ func (x *PrivacyFieldOptions_PersonalData) ClearFallbackBytes() {
 	}
 }
 
+func (x *PrivacyFieldOptions_PersonalData) ClearMask() {
+	x.xxx_hidden_Mask = nil
+}
+
+func (x *PrivacyFieldOptions_PersonalData) ClearPseudonymize() {
+	x.xxx_hidden_Pseudonymize = nil
+}
+
+func (x *PrivacyFieldOptions_PersonalData) ClearBlindIndex() {
+	x.xxx_hidden_BlindIndex = nil
+}
+
+func (x *PrivacyFieldOptions_PersonalData) ClearCategory() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Category = DataCategory_DATA_CATEGORY_UNSPECIFIED
+}
+
+func (x *PrivacyFieldOptions_PersonalData) ClearCustomCategory() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_CustomCategory = nil
+}
+
+func (x *PrivacyFieldOptions_PersonalData) ClearCondition() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Condition = nil
+}
+
+func (x *PrivacyFieldOptions_PersonalData) ClearRetention() {
+	x.xxx_hidden_Retention = nil
+}
+
 const PrivacyFieldOptions_PersonalData_Fallback_not_set_case case_PrivacyFieldOptions_PersonalData_Fallback = 0
 const PrivacyFieldOptions_PersonalData_FallbackDouble_case case_PrivacyFieldOptions_PersonalData_Fallback = 1
 const PrivacyFieldOptions_PersonalData_FallbackFloat_case case_PrivacyFieldOptions_PersonalData_Fallback = 2
@@ -902,6 +1500,13 @@ type PrivacyFieldOptions_PersonalData_builder struct {
 	FallbackString   *string
 	FallbackBytes    []byte
 	// -- end of xxx_hidden_Fallback
+	Mask           *PrivacyFieldOptions_Mask
+	Pseudonymize   *PrivacyFieldOptions_Pseudonymize
+	BlindIndex     *PrivacyFieldOptions_BlindIndex
+	Category       *DataCategory
+	CustomCategory *string
+	Condition      *string
+	Retention      *durationpb.Duration
 }
 
 func (b0 PrivacyFieldOptions_PersonalData_builder) Build() *PrivacyFieldOptions_PersonalData {
@@ -953,13 +1558,29 @@ func (b0 PrivacyFieldOptions_PersonalData_builder) Build() *PrivacyFieldOptions_
 	if b.FallbackBytes != nil {
 		x.xxx_hidden_Fallback = &privacyFieldOptions_PersonalData_FallbackBytes{b.FallbackBytes}
 	}
+	x.xxx_hidden_Mask = b.Mask
+	x.xxx_hidden_Pseudonymize = b.Pseudonymize
+	x.xxx_hidden_BlindIndex = b.BlindIndex
+	if b.Category != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
+		x.xxx_hidden_Category = *b.Category
+	}
+	if b.CustomCategory != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
+		x.xxx_hidden_CustomCategory = b.CustomCategory
+	}
+	if b.Condition != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_Condition = b.Condition
+	}
+	x.xxx_hidden_Retention = b.Retention
 	return m0
 }
 
 type case_PrivacyFieldOptions_PersonalData_Fallback protoreflect.FieldNumber
 
 func (x case_PrivacyFieldOptions_PersonalData_Fallback) String() string {
-	md := file_boostport_privacy_privacy_proto_msgTypes[3].Descriptor()
+	md := file_boostport_privacy_privacy_proto_msgTypes[5].Descriptor()
 	if x == 0 {
 		return "not set"
 	}
@@ -1074,6 +1695,541 @@ func (*privacyFieldOptions_PersonalData_FallbackString) isPrivacyFieldOptions_Pe
 func (*privacyFieldOptions_PersonalData_FallbackBytes) isPrivacyFieldOptions_PersonalData_Fallback() {
 }
 
+type PrivacyFieldOptions_Mask struct {
+	state                  protoimpl.MessageState              `protogen:"opaque.v1"`
+	xxx_hidden_Strategy    isPrivacyFieldOptions_Mask_Strategy `protobuf_oneof:"strategy"`
+	xxx_hidden_Character   *string                             `protobuf:"bytes,6,opt,name=character"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *PrivacyFieldOptions_Mask) Reset() {
+	*x = PrivacyFieldOptions_Mask{}
+	mi := &file_boostport_privacy_privacy_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacyFieldOptions_Mask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacyFieldOptions_Mask) ProtoMessage() {}
+
+func (x *PrivacyFieldOptions_Mask) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_privacy_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PrivacyFieldOptions_Mask) GetKeepLast() uint32 {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Strategy.(*privacyFieldOptions_Mask_KeepLast); ok {
+			return x.KeepLast
+		}
+	}
+	return 0
+}
+
+func (x *PrivacyFieldOptions_Mask) GetKeepFirst() uint32 {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Strategy.(*privacyFieldOptions_Mask_KeepFirst); ok {
+			return x.KeepFirst
+		}
+	}
+	return 0
+}
+
+func (x *PrivacyFieldOptions_Mask) GetEmailDomain() bool {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Strategy.(*privacyFieldOptions_Mask_EmailDomain); ok {
+			return x.EmailDomain
+		}
+	}
+	return false
+}
+
+func (x *PrivacyFieldOptions_Mask) GetFixedLength() uint32 {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Strategy.(*privacyFieldOptions_Mask_FixedLength); ok {
+			return x.FixedLength
+		}
+	}
+	return 0
+}
+
+func (x *PrivacyFieldOptions_Mask) GetHash() bool {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Strategy.(*privacyFieldOptions_Mask_Hash); ok {
+			return x.Hash
+		}
+	}
+	return false
+}
+
+func (x *PrivacyFieldOptions_Mask) GetCharacter() string {
+	if x != nil {
+		if x.xxx_hidden_Character != nil {
+			return *x.xxx_hidden_Character
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *PrivacyFieldOptions_Mask) SetKeepLast(v uint32) {
+	x.xxx_hidden_Strategy = &privacyFieldOptions_Mask_KeepLast{v}
+}
+
+func (x *PrivacyFieldOptions_Mask) SetKeepFirst(v uint32) {
+	x.xxx_hidden_Strategy = &privacyFieldOptions_Mask_KeepFirst{v}
+}
+
+func (x *PrivacyFieldOptions_Mask) SetEmailDomain(v bool) {
+	x.xxx_hidden_Strategy = &privacyFieldOptions_Mask_EmailDomain{v}
+}
+
+func (x *PrivacyFieldOptions_Mask) SetFixedLength(v uint32) {
+	x.xxx_hidden_Strategy = &privacyFieldOptions_Mask_FixedLength{v}
+}
+
+func (x *PrivacyFieldOptions_Mask) SetHash(v bool) {
+	x.xxx_hidden_Strategy = &privacyFieldOptions_Mask_Hash{v}
+}
+
+func (x *PrivacyFieldOptions_Mask) SetCharacter(v string) {
+	x.xxx_hidden_Character = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *PrivacyFieldOptions_Mask) HasStrategy() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Strategy != nil
+}
+
+func (x *PrivacyFieldOptions_Mask) HasKeepLast() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Strategy.(*privacyFieldOptions_Mask_KeepLast)
+	return ok
+}
+
+func (x *PrivacyFieldOptions_Mask) HasKeepFirst() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Strategy.(*privacyFieldOptions_Mask_KeepFirst)
+	return ok
+}
+
+func (x *PrivacyFieldOptions_Mask) HasEmailDomain() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Strategy.(*privacyFieldOptions_Mask_EmailDomain)
+	return ok
+}
+
+func (x *PrivacyFieldOptions_Mask) HasFixedLength() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Strategy.(*privacyFieldOptions_Mask_FixedLength)
+	return ok
+}
+
+func (x *PrivacyFieldOptions_Mask) HasHash() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Strategy.(*privacyFieldOptions_Mask_Hash)
+	return ok
+}
+
+func (x *PrivacyFieldOptions_Mask) HasCharacter() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *PrivacyFieldOptions_Mask) ClearStrategy() {
+	x.xxx_hidden_Strategy = nil
+}
+
+func (x *PrivacyFieldOptions_Mask) ClearKeepLast() {
+	if _, ok := x.xxx_hidden_Strategy.(*privacyFieldOptions_Mask_KeepLast); ok {
+		x.xxx_hidden_Strategy = nil
+	}
+}
+
+func (x *PrivacyFieldOptions_Mask) ClearKeepFirst() {
+	if _, ok := x.xxx_hidden_Strategy.(*privacyFieldOptions_Mask_KeepFirst); ok {
+		x.xxx_hidden_Strategy = nil
+	}
+}
+
+func (x *PrivacyFieldOptions_Mask) ClearEmailDomain() {
+	if _, ok := x.xxx_hidden_Strategy.(*privacyFieldOptions_Mask_EmailDomain); ok {
+		x.xxx_hidden_Strategy = nil
+	}
+}
+
+func (x *PrivacyFieldOptions_Mask) ClearFixedLength() {
+	if _, ok := x.xxx_hidden_Strategy.(*privacyFieldOptions_Mask_FixedLength); ok {
+		x.xxx_hidden_Strategy = nil
+	}
+}
+
+func (x *PrivacyFieldOptions_Mask) ClearHash() {
+	if _, ok := x.xxx_hidden_Strategy.(*privacyFieldOptions_Mask_Hash); ok {
+		x.xxx_hidden_Strategy = nil
+	}
+}
+
+func (x *PrivacyFieldOptions_Mask) ClearCharacter() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Character = nil
+}
+
+const PrivacyFieldOptions_Mask_Strategy_not_set_case case_PrivacyFieldOptions_Mask_Strategy = 0
+const PrivacyFieldOptions_Mask_KeepLast_case case_PrivacyFieldOptions_Mask_Strategy = 1
+const PrivacyFieldOptions_Mask_KeepFirst_case case_PrivacyFieldOptions_Mask_Strategy = 2
+const PrivacyFieldOptions_Mask_EmailDomain_case case_PrivacyFieldOptions_Mask_Strategy = 3
+const PrivacyFieldOptions_Mask_FixedLength_case case_PrivacyFieldOptions_Mask_Strategy = 4
+const PrivacyFieldOptions_Mask_Hash_case case_PrivacyFieldOptions_Mask_Strategy = 5
+
+func (x *PrivacyFieldOptions_Mask) WhichStrategy() case_PrivacyFieldOptions_Mask_Strategy {
+	if x == nil {
+		return PrivacyFieldOptions_Mask_Strategy_not_set_case
+	}
+	switch x.xxx_hidden_Strategy.(type) {
+	case *privacyFieldOptions_Mask_KeepLast:
+		return PrivacyFieldOptions_Mask_KeepLast_case
+	case *privacyFieldOptions_Mask_KeepFirst:
+		return PrivacyFieldOptions_Mask_KeepFirst_case
+	case *privacyFieldOptions_Mask_EmailDomain:
+		return PrivacyFieldOptions_Mask_EmailDomain_case
+	case *privacyFieldOptions_Mask_FixedLength:
+		return PrivacyFieldOptions_Mask_FixedLength_case
+	case *privacyFieldOptions_Mask_Hash:
+		return PrivacyFieldOptions_Mask_Hash_case
+	default:
+		return PrivacyFieldOptions_Mask_Strategy_not_set_case
+	}
+}
+
+type PrivacyFieldOptions_Mask_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Fields of oneof xxx_hidden_Strategy:
+	KeepLast    *uint32
+	KeepFirst   *uint32
+	EmailDomain *bool
+	FixedLength *uint32
+	Hash        *bool
+	// -- end of xxx_hidden_Strategy
+	Character *string
+}
+
+func (b0 PrivacyFieldOptions_Mask_builder) Build() *PrivacyFieldOptions_Mask {
+	m0 := &PrivacyFieldOptions_Mask{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.KeepLast != nil {
+		x.xxx_hidden_Strategy = &privacyFieldOptions_Mask_KeepLast{*b.KeepLast}
+	}
+	if b.KeepFirst != nil {
+		x.xxx_hidden_Strategy = &privacyFieldOptions_Mask_KeepFirst{*b.KeepFirst}
+	}
+	if b.EmailDomain != nil {
+		x.xxx_hidden_Strategy = &privacyFieldOptions_Mask_EmailDomain{*b.EmailDomain}
+	}
+	if b.FixedLength != nil {
+		x.xxx_hidden_Strategy = &privacyFieldOptions_Mask_FixedLength{*b.FixedLength}
+	}
+	if b.Hash != nil {
+		x.xxx_hidden_Strategy = &privacyFieldOptions_Mask_Hash{*b.Hash}
+	}
+	if b.Character != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Character = b.Character
+	}
+	return m0
+}
+
+type case_PrivacyFieldOptions_Mask_Strategy protoreflect.FieldNumber
+
+func (x case_PrivacyFieldOptions_Mask_Strategy) String() string {
+	md := file_boostport_privacy_privacy_proto_msgTypes[6].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isPrivacyFieldOptions_Mask_Strategy interface {
+	isPrivacyFieldOptions_Mask_Strategy()
+}
+
+type privacyFieldOptions_Mask_KeepLast struct {
+	KeepLast uint32 `protobuf:"varint,1,opt,name=keep_last,json=keepLast,oneof"`
+}
+
+type privacyFieldOptions_Mask_KeepFirst struct {
+	KeepFirst uint32 `protobuf:"varint,2,opt,name=keep_first,json=keepFirst,oneof"`
+}
+
+type privacyFieldOptions_Mask_EmailDomain struct {
+	EmailDomain bool `protobuf:"varint,3,opt,name=email_domain,json=emailDomain,oneof"`
+}
+
+type privacyFieldOptions_Mask_FixedLength struct {
+	FixedLength uint32 `protobuf:"varint,4,opt,name=fixed_length,json=fixedLength,oneof"`
+}
+
+type privacyFieldOptions_Mask_Hash struct {
+	Hash bool `protobuf:"varint,5,opt,name=hash,oneof"`
+}
+
+func (*privacyFieldOptions_Mask_KeepLast) isPrivacyFieldOptions_Mask_Strategy() {}
+
+func (*privacyFieldOptions_Mask_KeepFirst) isPrivacyFieldOptions_Mask_Strategy() {}
+
+func (*privacyFieldOptions_Mask_EmailDomain) isPrivacyFieldOptions_Mask_Strategy() {}
+
+func (*privacyFieldOptions_Mask_FixedLength) isPrivacyFieldOptions_Mask_Strategy() {}
+
+func (*privacyFieldOptions_Mask_Hash) isPrivacyFieldOptions_Mask_Strategy() {}
+
+type PrivacyFieldOptions_Pseudonymize struct {
+	state                  protoimpl.MessageState                 `protogen:"opaque.v1"`
+	xxx_hidden_Scope       PrivacyFieldOptions_Pseudonymize_Scope `protobuf:"varint,1,opt,name=scope,enum=boostport.privacy.PrivacyFieldOptions_Pseudonymize_Scope"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *PrivacyFieldOptions_Pseudonymize) Reset() {
+	*x = PrivacyFieldOptions_Pseudonymize{}
+	mi := &file_boostport_privacy_privacy_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacyFieldOptions_Pseudonymize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacyFieldOptions_Pseudonymize) ProtoMessage() {}
+
+func (x *PrivacyFieldOptions_Pseudonymize) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_privacy_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PrivacyFieldOptions_Pseudonymize) GetScope() PrivacyFieldOptions_Pseudonymize_Scope {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Scope
+		}
+	}
+	return PrivacyFieldOptions_Pseudonymize_SCOPE_UNSPECIFIED
+}
+
+func (x *PrivacyFieldOptions_Pseudonymize) SetScope(v PrivacyFieldOptions_Pseudonymize_Scope) {
+	x.xxx_hidden_Scope = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *PrivacyFieldOptions_Pseudonymize) HasScope() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *PrivacyFieldOptions_Pseudonymize) ClearScope() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Scope = PrivacyFieldOptions_Pseudonymize_SCOPE_UNSPECIFIED
+}
+
+type PrivacyFieldOptions_Pseudonymize_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Scope *PrivacyFieldOptions_Pseudonymize_Scope
+}
+
+func (b0 PrivacyFieldOptions_Pseudonymize_builder) Build() *PrivacyFieldOptions_Pseudonymize {
+	m0 := &PrivacyFieldOptions_Pseudonymize{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Scope != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Scope = *b.Scope
+	}
+	return m0
+}
+
+type PrivacyFieldOptions_BlindIndex struct {
+	state                    protoimpl.MessageState                       `protogen:"opaque.v1"`
+	xxx_hidden_Field         *string                                      `protobuf:"bytes,1,opt,name=field"`
+	xxx_hidden_Length        uint32                                       `protobuf:"varint,2,opt,name=length"`
+	xxx_hidden_Normalization PrivacyFieldOptions_BlindIndex_Normalization `protobuf:"varint,3,opt,name=normalization,enum=boostport.privacy.PrivacyFieldOptions_BlindIndex_Normalization"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *PrivacyFieldOptions_BlindIndex) Reset() {
+	*x = PrivacyFieldOptions_BlindIndex{}
+	mi := &file_boostport_privacy_privacy_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacyFieldOptions_BlindIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacyFieldOptions_BlindIndex) ProtoMessage() {}
+
+func (x *PrivacyFieldOptions_BlindIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_privacy_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PrivacyFieldOptions_BlindIndex) GetField() string {
+	if x != nil {
+		if x.xxx_hidden_Field != nil {
+			return *x.xxx_hidden_Field
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *PrivacyFieldOptions_BlindIndex) GetLength() uint32 {
+	if x != nil {
+		return x.xxx_hidden_Length
+	}
+	return 0
+}
+
+func (x *PrivacyFieldOptions_BlindIndex) GetNormalization() PrivacyFieldOptions_BlindIndex_Normalization {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_Normalization
+		}
+	}
+	return PrivacyFieldOptions_BlindIndex_NORMALIZATION_UNSPECIFIED
+}
+
+func (x *PrivacyFieldOptions_BlindIndex) SetField(v string) {
+	x.xxx_hidden_Field = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *PrivacyFieldOptions_BlindIndex) SetLength(v uint32) {
+	x.xxx_hidden_Length = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *PrivacyFieldOptions_BlindIndex) SetNormalization(v PrivacyFieldOptions_BlindIndex_Normalization) {
+	x.xxx_hidden_Normalization = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *PrivacyFieldOptions_BlindIndex) HasField() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *PrivacyFieldOptions_BlindIndex) HasLength() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *PrivacyFieldOptions_BlindIndex) HasNormalization() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *PrivacyFieldOptions_BlindIndex) ClearField() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Field = nil
+}
+
+func (x *PrivacyFieldOptions_BlindIndex) ClearLength() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Length = 0
+}
+
+func (x *PrivacyFieldOptions_BlindIndex) ClearNormalization() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Normalization = PrivacyFieldOptions_BlindIndex_NORMALIZATION_UNSPECIFIED
+}
+
+type PrivacyFieldOptions_BlindIndex_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Field         *string
+	Length        *uint32
+	Normalization *PrivacyFieldOptions_BlindIndex_Normalization
+}
+
+func (b0 PrivacyFieldOptions_BlindIndex_builder) Build() *PrivacyFieldOptions_BlindIndex {
+	m0 := &PrivacyFieldOptions_BlindIndex{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Field != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Field = b.Field
+	}
+	if b.Length != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Length = *b.Length
+	}
+	if b.Normalization != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Normalization = *b.Normalization
+	}
+	return m0
+}
+
 var file_boostport_privacy_privacy_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
@@ -1083,6 +2239,14 @@ var file_boostport_privacy_privacy_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,2000,opt,name=field",
 		Filename:      "boostport/privacy/privacy.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*PrivacyMessageOptions)(nil),
+		Field:         2000,
+		Name:          "boostport.privacy.message",
+		Tag:           "bytes,2000,opt,name=message",
+		Filename:      "boostport/privacy/privacy.proto",
+	},
 }
 
 // Extension fields to descriptorpb.FieldOptions.
@@ -1091,19 +2255,41 @@ var (
 	E_Field = &file_boostport_privacy_privacy_proto_extTypes[0]
 )
 
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// optional boostport.privacy.PrivacyMessageOptions message = 2000;
+	E_Message = &file_boostport_privacy_privacy_proto_extTypes[1]
+)
+
 var File_boostport_privacy_privacy_proto protoreflect.FileDescriptor
 
 const file_boostport_privacy_privacy_proto_rawDesc = "" +
 	"\n" +
-	"\x1fboostport/privacy/privacy.proto\x12\x11boostport.privacy\x1a\x19google/protobuf/any.proto\x1a google/protobuf/descriptor.proto\"a\n" +
+	"\x1fboostport/privacy/privacy.proto\x12\x11boostport.privacy\x1a\x19google/protobuf/any.proto\x1a google/protobuf/descriptor.proto\x1a\x1egoogle/protobuf/duration.proto\"\xb7\x02\n" +
 	"\bEnvelope\x12.\n" +
 	"\amessage\x18\x01 \x01(\v2\x14.google.protobuf.AnyR\amessage\x12%\n" +
-	"\x0eencrypted_data\x18\x02 \x01(\fR\rencryptedData\"\xa4\a\n" +
+	"\x0eencrypted_data\x18\x02 \x01(\fR\rencryptedData\x12R\n" +
+	"\rblind_indexes\x18\x03 \x03(\v2-.boostport.privacy.Envelope.BlindIndexesEntryR\fblindIndexes\x12\x1d\n" +
+	"\n" +
+	"key_bucket\x18\x04 \x01(\tR\tkeyBucket\x12 \n" +
+	"\vcompression\x18\x05 \x01(\tR\vcompression\x1a?\n" +
+	"\x11BlindIndexesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"S\n" +
+	"\x15PrivacyMessageOptions\x12:\n" +
+	"\x19retention_timestamp_field\x18\x01 \x01(\tR\x17retentionTimestampField\"\xe5\x11\n" +
 	"\x13PrivacyFieldOptions\x12^\n" +
 	"\x0fdata_subject_id\x18\x01 \x01(\v24.boostport.privacy.PrivacyFieldOptions.DataSubjectIDH\x00R\rdataSubjectId\x12Z\n" +
-	"\rpersonal_data\x18\x02 \x01(\v23.boostport.privacy.PrivacyFieldOptions.PersonalDataH\x00R\fpersonalData\x1a'\n" +
+	"\rpersonal_data\x18\x02 \x01(\v23.boostport.privacy.PrivacyFieldOptions.PersonalDataH\x00R\fpersonalData\x1a\x9a\x02\n" +
 	"\rDataSubjectID\x12\x16\n" +
-	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x1a\x9f\x05\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12]\n" +
+	"\n" +
+	"key_bucket\x18\x02 \x01(\x0e2>.boostport.privacy.PrivacyFieldOptions.DataSubjectID.KeyBucketR\tkeyBucket\x12;\n" +
+	"\x1akey_bucket_timestamp_field\x18\x03 \x01(\tR\x17keyBucketTimestampField\"U\n" +
+	"\tKeyBucket\x12\x1a\n" +
+	"\x16KEY_BUCKET_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10KEY_BUCKET_MONTH\x10\x01\x12\x16\n" +
+	"\x12KEY_BUCKET_QUARTER\x10\x02\x1a\xca\b\n" +
 	"\fPersonalData\x12)\n" +
 	"\x0ffallback_double\x18\x01 \x01(\x01H\x00R\x0efallbackDouble\x12'\n" +
 	"\x0efallback_float\x18\x02 \x01(\x02H\x00R\rfallbackFloat\x12'\n" +
@@ -1120,33 +2306,101 @@ const file_boostport_privacy_privacy_proto_rawDesc = "" +
 	"\x11fallback_sfixed64\x18\f \x01(\x10H\x00R\x10fallbackSfixed64\x12%\n" +
 	"\rfallback_bool\x18\r \x01(\bH\x00R\ffallbackBool\x12)\n" +
 	"\x0ffallback_string\x18\x0e \x01(\tH\x00R\x0efallbackString\x12'\n" +
-	"\x0efallback_bytes\x18\x0f \x01(\fH\x00R\rfallbackBytesB\n" +
+	"\x0efallback_bytes\x18\x0f \x01(\fH\x00R\rfallbackBytes\x12?\n" +
+	"\x04mask\x18\x10 \x01(\v2+.boostport.privacy.PrivacyFieldOptions.MaskR\x04mask\x12W\n" +
+	"\fpseudonymize\x18\x11 \x01(\v23.boostport.privacy.PrivacyFieldOptions.PseudonymizeR\fpseudonymize\x12R\n" +
+	"\vblind_index\x18\x12 \x01(\v21.boostport.privacy.PrivacyFieldOptions.BlindIndexR\n" +
+	"blindIndex\x12;\n" +
+	"\bcategory\x18\x13 \x01(\x0e2\x1f.boostport.privacy.DataCategoryR\bcategory\x12'\n" +
+	"\x0fcustom_category\x18\x14 \x01(\tR\x0ecustomCategory\x12\x1c\n" +
+	"\tcondition\x18\x15 \x01(\tR\tcondition\x127\n" +
+	"\tretention\x18\x16 \x01(\v2\x19.google.protobuf.DurationR\tretentionB\n" +
+	"\n" +
+	"\bfallback\x1a\xd0\x01\n" +
+	"\x04Mask\x12\x1d\n" +
+	"\tkeep_last\x18\x01 \x01(\rH\x00R\bkeepLast\x12\x1f\n" +
 	"\n" +
-	"\bfallbackB\x06\n" +
-	"\x04type:\\\n" +
-	"\x05field\x12\x1d.google.protobuf.FieldOptions\x18\xd0\x0f \x01(\v2&.boostport.privacy.PrivacyFieldOptionsR\x05fieldB\xd2\x01\n" +
+	"keep_first\x18\x02 \x01(\rH\x00R\tkeepFirst\x12#\n" +
+	"\femail_domain\x18\x03 \x01(\bH\x00R\vemailDomain\x12#\n" +
+	"\ffixed_length\x18\x04 \x01(\rH\x00R\vfixedLength\x12\x14\n" +
+	"\x04hash\x18\x05 \x01(\bH\x00R\x04hash\x12\x1c\n" +
+	"\tcharacter\x18\x06 \x01(\tR\tcharacterB\n" +
+	"\n" +
+	"\bstrategy\x1a\xa9\x01\n" +
+	"\fPseudonymize\x12O\n" +
+	"\x05scope\x18\x01 \x01(\x0e29.boostport.privacy.PrivacyFieldOptions.Pseudonymize.ScopeR\x05scope\"H\n" +
+	"\x05Scope\x12\x15\n" +
+	"\x11SCOPE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fSCOPE_GLOBAL\x10\x01\x12\x16\n" +
+	"\x12SCOPE_DATA_SUBJECT\x10\x02\x1a\xa0\x02\n" +
+	"\n" +
+	"BlindIndex\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\rR\x06length\x12e\n" +
+	"\rnormalization\x18\x03 \x01(\x0e2?.boostport.privacy.PrivacyFieldOptions.BlindIndex.NormalizationR\rnormalization\"}\n" +
+	"\rNormalization\x12\x1d\n" +
+	"\x19NORMALIZATION_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12NORMALIZATION_NONE\x10\x01\x12\x1b\n" +
+	"\x17NORMALIZATION_LOWERCASE\x10\x02\x12\x18\n" +
+	"\x14NORMALIZATION_DIGITS\x10\x03B\x06\n" +
+	"\x04type*\x8e\x02\n" +
+	"\fDataCategory\x12\x1d\n" +
+	"\x19DATA_CATEGORY_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15DATA_CATEGORY_CONTACT\x10\x01\x12\x1a\n" +
+	"\x16DATA_CATEGORY_IDENTITY\x10\x02\x12\x1d\n" +
+	"\x19DATA_CATEGORY_DEMOGRAPHIC\x10\x03\x12\x1a\n" +
+	"\x16DATA_CATEGORY_LOCATION\x10\x04\x12\x1b\n" +
+	"\x17DATA_CATEGORY_FINANCIAL\x10\x05\x12\x18\n" +
+	"\x14DATA_CATEGORY_HEALTH\x10\x06\x12\x1b\n" +
+	"\x17DATA_CATEGORY_BIOMETRIC\x10\a\x12\x19\n" +
+	"\x15DATA_CATEGORY_GENETIC\x10\b:\\\n" +
+	"\x05field\x12\x1d.google.protobuf.FieldOptions\x18\xd0\x0f \x01(\v2&.boostport.privacy.PrivacyFieldOptionsR\x05field:d\n" +
+	"\amessage\x12\x1f.google.protobuf.MessageOptions\x18\xd0\x0f \x01(\v2(.boostport.privacy.PrivacyMessageOptionsR\amessageB\xd2\x01\n" +
 	"\x15com.boostport.privacyB\fPrivacyProtoP\x01ZFgithub.com/Boostport/protoprivacy/internal/generated/boostport/privacy\xa2\x02\x03BPX\xaa\x02\x11Boostport.Privacy\xca\x02\x11Boostport\\Privacy\xe2\x02\x1dBoostport\\Privacy\\GPBMetadata\xea\x02\x12Boostport::Privacyb\beditionsp\xe8\a"
 
-var file_boostport_privacy_privacy_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_boostport_privacy_privacy_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_boostport_privacy_privacy_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_boostport_privacy_privacy_proto_goTypes = []any{
-	(*Envelope)(nil),                          // 0: boostport.privacy.Envelope
-	(*PrivacyFieldOptions)(nil),               // 1: boostport.privacy.PrivacyFieldOptions
-	(*PrivacyFieldOptions_DataSubjectID)(nil), // 2: boostport.privacy.PrivacyFieldOptions.DataSubjectID
-	(*PrivacyFieldOptions_PersonalData)(nil),  // 3: boostport.privacy.PrivacyFieldOptions.PersonalData
-	(*anypb.Any)(nil),                         // 4: google.protobuf.Any
-	(*descriptorpb.FieldOptions)(nil),         // 5: google.protobuf.FieldOptions
+	(DataCategory)(0), // 0: boostport.privacy.DataCategory
+	(PrivacyFieldOptions_DataSubjectID_KeyBucket)(0),  // 1: boostport.privacy.PrivacyFieldOptions.DataSubjectID.KeyBucket
+	(PrivacyFieldOptions_Pseudonymize_Scope)(0),       // 2: boostport.privacy.PrivacyFieldOptions.Pseudonymize.Scope
+	(PrivacyFieldOptions_BlindIndex_Normalization)(0), // 3: boostport.privacy.PrivacyFieldOptions.BlindIndex.Normalization
+	(*Envelope)(nil),              // 4: boostport.privacy.Envelope
+	(*PrivacyMessageOptions)(nil), // 5: boostport.privacy.PrivacyMessageOptions
+	(*PrivacyFieldOptions)(nil),   // 6: boostport.privacy.PrivacyFieldOptions
+	nil,                           // 7: boostport.privacy.Envelope.BlindIndexesEntry
+	(*PrivacyFieldOptions_DataSubjectID)(nil), // 8: boostport.privacy.PrivacyFieldOptions.DataSubjectID
+	(*PrivacyFieldOptions_PersonalData)(nil),  // 9: boostport.privacy.PrivacyFieldOptions.PersonalData
+	(*PrivacyFieldOptions_Mask)(nil),          // 10: boostport.privacy.PrivacyFieldOptions.Mask
+	(*PrivacyFieldOptions_Pseudonymize)(nil),  // 11: boostport.privacy.PrivacyFieldOptions.Pseudonymize
+	(*PrivacyFieldOptions_BlindIndex)(nil),    // 12: boostport.privacy.PrivacyFieldOptions.BlindIndex
+	(*anypb.Any)(nil),                         // 13: google.protobuf.Any
+	(*durationpb.Duration)(nil),               // 14: google.protobuf.Duration
+	(*descriptorpb.FieldOptions)(nil),         // 15: google.protobuf.FieldOptions
+	(*descriptorpb.MessageOptions)(nil),       // 16: google.protobuf.MessageOptions
 }
 var file_boostport_privacy_privacy_proto_depIdxs = []int32{
-	4, // 0: boostport.privacy.Envelope.message:type_name -> google.protobuf.Any
-	2, // 1: boostport.privacy.PrivacyFieldOptions.data_subject_id:type_name -> boostport.privacy.PrivacyFieldOptions.DataSubjectID
-	3, // 2: boostport.privacy.PrivacyFieldOptions.personal_data:type_name -> boostport.privacy.PrivacyFieldOptions.PersonalData
-	5, // 3: boostport.privacy.field:extendee -> google.protobuf.FieldOptions
-	1, // 4: boostport.privacy.field:type_name -> boostport.privacy.PrivacyFieldOptions
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	4, // [4:5] is the sub-list for extension type_name
-	3, // [3:4] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	13, // 0: boostport.privacy.Envelope.message:type_name -> google.protobuf.Any
+	7,  // 1: boostport.privacy.Envelope.blind_indexes:type_name -> boostport.privacy.Envelope.BlindIndexesEntry
+	8,  // 2: boostport.privacy.PrivacyFieldOptions.data_subject_id:type_name -> boostport.privacy.PrivacyFieldOptions.DataSubjectID
+	9,  // 3: boostport.privacy.PrivacyFieldOptions.personal_data:type_name -> boostport.privacy.PrivacyFieldOptions.PersonalData
+	1,  // 4: boostport.privacy.PrivacyFieldOptions.DataSubjectID.key_bucket:type_name -> boostport.privacy.PrivacyFieldOptions.DataSubjectID.KeyBucket
+	10, // 5: boostport.privacy.PrivacyFieldOptions.PersonalData.mask:type_name -> boostport.privacy.PrivacyFieldOptions.Mask
+	11, // 6: boostport.privacy.PrivacyFieldOptions.PersonalData.pseudonymize:type_name -> boostport.privacy.PrivacyFieldOptions.Pseudonymize
+	12, // 7: boostport.privacy.PrivacyFieldOptions.PersonalData.blind_index:type_name -> boostport.privacy.PrivacyFieldOptions.BlindIndex
+	0,  // 8: boostport.privacy.PrivacyFieldOptions.PersonalData.category:type_name -> boostport.privacy.DataCategory
+	14, // 9: boostport.privacy.PrivacyFieldOptions.PersonalData.retention:type_name -> google.protobuf.Duration
+	2,  // 10: boostport.privacy.PrivacyFieldOptions.Pseudonymize.scope:type_name -> boostport.privacy.PrivacyFieldOptions.Pseudonymize.Scope
+	3,  // 11: boostport.privacy.PrivacyFieldOptions.BlindIndex.normalization:type_name -> boostport.privacy.PrivacyFieldOptions.BlindIndex.Normalization
+	15, // 12: boostport.privacy.field:extendee -> google.protobuf.FieldOptions
+	16, // 13: boostport.privacy.message:extendee -> google.protobuf.MessageOptions
+	6,  // 14: boostport.privacy.field:type_name -> boostport.privacy.PrivacyFieldOptions
+	5,  // 15: boostport.privacy.message:type_name -> boostport.privacy.PrivacyMessageOptions
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	14, // [14:16] is the sub-list for extension type_name
+	12, // [12:14] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_boostport_privacy_privacy_proto_init() }
@@ -1154,11 +2408,11 @@ func file_boostport_privacy_privacy_proto_init() {
 	if File_boostport_privacy_privacy_proto != nil {
 		return
 	}
-	file_boostport_privacy_privacy_proto_msgTypes[1].OneofWrappers = []any{
+	file_boostport_privacy_privacy_proto_msgTypes[2].OneofWrappers = []any{
 		(*privacyFieldOptions_DataSubjectId)(nil),
 		(*privacyFieldOptions_PersonalData_)(nil),
 	}
-	file_boostport_privacy_privacy_proto_msgTypes[3].OneofWrappers = []any{
+	file_boostport_privacy_privacy_proto_msgTypes[5].OneofWrappers = []any{
 		(*privacyFieldOptions_PersonalData_FallbackDouble)(nil),
 		(*privacyFieldOptions_PersonalData_FallbackFloat)(nil),
 		(*privacyFieldOptions_PersonalData_FallbackInt32)(nil),
@@ -1175,18 +2429,26 @@ func file_boostport_privacy_privacy_proto_init() {
 		(*privacyFieldOptions_PersonalData_FallbackString)(nil),
 		(*privacyFieldOptions_PersonalData_FallbackBytes)(nil),
 	}
+	file_boostport_privacy_privacy_proto_msgTypes[6].OneofWrappers = []any{
+		(*privacyFieldOptions_Mask_KeepLast)(nil),
+		(*privacyFieldOptions_Mask_KeepFirst)(nil),
+		(*privacyFieldOptions_Mask_EmailDomain)(nil),
+		(*privacyFieldOptions_Mask_FixedLength)(nil),
+		(*privacyFieldOptions_Mask_Hash)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_boostport_privacy_privacy_proto_rawDesc), len(file_boostport_privacy_privacy_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
-			NumExtensions: 1,
+			NumEnums:      4,
+			NumMessages:   9,
+			NumExtensions: 2,
 			NumServices:   0,
 		},
 		GoTypes:           file_boostport_privacy_privacy_proto_goTypes,
 		DependencyIndexes: file_boostport_privacy_privacy_proto_depIdxs,
+		EnumInfos:         file_boostport_privacy_privacy_proto_enumTypes,
 		MessageInfos:      file_boostport_privacy_privacy_proto_msgTypes,
 		ExtensionInfos:    file_boostport_privacy_privacy_proto_extTypes,
 	}.Build()
@@ -10,6 +10,7 @@ import (
 	_ "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	unsafe "unsafe"
 )
@@ -2062,6 +2063,3143 @@ func (b0 InvalidFallbackTypes_builder) Build() *InvalidFallbackTypes {
 	return m0
 }
 
+type InvalidMaskNonString struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       int32                  `protobuf:"varint,2,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidMaskNonString) Reset() {
+	*x = InvalidMaskNonString{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidMaskNonString) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidMaskNonString) ProtoMessage() {}
+
+func (x *InvalidMaskNonString) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidMaskNonString) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidMaskNonString) GetData1() int32 {
+	if x != nil {
+		return x.xxx_hidden_Data1
+	}
+	return 0
+}
+
+func (x *InvalidMaskNonString) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *InvalidMaskNonString) SetData1(v int32) {
+	x.xxx_hidden_Data1 = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *InvalidMaskNonString) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidMaskNonString) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidMaskNonString) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidMaskNonString) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = 0
+}
+
+type InvalidMaskNonString_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *int32
+}
+
+func (b0 InvalidMaskNonString_builder) Build() *InvalidMaskNonString {
+	m0 := &InvalidMaskNonString{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1 = *b.Data1
+	}
+	return m0
+}
+
+type InvalidMaskRepeated struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       []string               `protobuf:"bytes,2,rep,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidMaskRepeated) Reset() {
+	*x = InvalidMaskRepeated{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidMaskRepeated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidMaskRepeated) ProtoMessage() {}
+
+func (x *InvalidMaskRepeated) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidMaskRepeated) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidMaskRepeated) GetData1() []string {
+	if x != nil {
+		return x.xxx_hidden_Data1
+	}
+	return nil
+}
+
+func (x *InvalidMaskRepeated) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *InvalidMaskRepeated) SetData1(v []string) {
+	x.xxx_hidden_Data1 = v
+}
+
+func (x *InvalidMaskRepeated) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidMaskRepeated) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type InvalidMaskRepeated_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 []string
+}
+
+func (b0 InvalidMaskRepeated_builder) Build() *InvalidMaskRepeated {
+	m0 := &InvalidMaskRepeated{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_Data1 = b.Data1
+	return m0
+}
+
+type InvalidMaskCharacterEmpty struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidMaskCharacterEmpty) Reset() {
+	*x = InvalidMaskCharacterEmpty{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidMaskCharacterEmpty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidMaskCharacterEmpty) ProtoMessage() {}
+
+func (x *InvalidMaskCharacterEmpty) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidMaskCharacterEmpty) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidMaskCharacterEmpty) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidMaskCharacterEmpty) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *InvalidMaskCharacterEmpty) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *InvalidMaskCharacterEmpty) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidMaskCharacterEmpty) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidMaskCharacterEmpty) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidMaskCharacterEmpty) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidMaskCharacterEmpty_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+}
+
+func (b0 InvalidMaskCharacterEmpty_builder) Build() *InvalidMaskCharacterEmpty {
+	m0 := &InvalidMaskCharacterEmpty{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidMaskCharacterMultiple struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidMaskCharacterMultiple) Reset() {
+	*x = InvalidMaskCharacterMultiple{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidMaskCharacterMultiple) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidMaskCharacterMultiple) ProtoMessage() {}
+
+func (x *InvalidMaskCharacterMultiple) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidMaskCharacterMultiple) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidMaskCharacterMultiple) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidMaskCharacterMultiple) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *InvalidMaskCharacterMultiple) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *InvalidMaskCharacterMultiple) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidMaskCharacterMultiple) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidMaskCharacterMultiple) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidMaskCharacterMultiple) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidMaskCharacterMultiple_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+}
+
+func (b0 InvalidMaskCharacterMultiple_builder) Build() *InvalidMaskCharacterMultiple {
+	m0 := &InvalidMaskCharacterMultiple{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidPseudonymizeNonString struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       []byte                 `protobuf:"bytes,2,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidPseudonymizeNonString) Reset() {
+	*x = InvalidPseudonymizeNonString{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidPseudonymizeNonString) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidPseudonymizeNonString) ProtoMessage() {}
+
+func (x *InvalidPseudonymizeNonString) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidPseudonymizeNonString) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidPseudonymizeNonString) GetData1() []byte {
+	if x != nil {
+		return x.xxx_hidden_Data1
+	}
+	return nil
+}
+
+func (x *InvalidPseudonymizeNonString) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *InvalidPseudonymizeNonString) SetData1(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Data1 = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *InvalidPseudonymizeNonString) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidPseudonymizeNonString) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidPseudonymizeNonString) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidPseudonymizeNonString) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidPseudonymizeNonString_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 []byte
+}
+
+func (b0 InvalidPseudonymizeNonString_builder) Build() *InvalidPseudonymizeNonString {
+	m0 := &InvalidPseudonymizeNonString{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidPseudonymizeWithMask struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidPseudonymizeWithMask) Reset() {
+	*x = InvalidPseudonymizeWithMask{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidPseudonymizeWithMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidPseudonymizeWithMask) ProtoMessage() {}
+
+func (x *InvalidPseudonymizeWithMask) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidPseudonymizeWithMask) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidPseudonymizeWithMask) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidPseudonymizeWithMask) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *InvalidPseudonymizeWithMask) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *InvalidPseudonymizeWithMask) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidPseudonymizeWithMask) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidPseudonymizeWithMask) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidPseudonymizeWithMask) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidPseudonymizeWithMask_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+}
+
+func (b0 InvalidPseudonymizeWithMask_builder) Build() *InvalidPseudonymizeWithMask {
+	m0 := &InvalidPseudonymizeWithMask{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidBlindIndexMissingTarget struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidBlindIndexMissingTarget) Reset() {
+	*x = InvalidBlindIndexMissingTarget{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidBlindIndexMissingTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidBlindIndexMissingTarget) ProtoMessage() {}
+
+func (x *InvalidBlindIndexMissingTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidBlindIndexMissingTarget) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidBlindIndexMissingTarget) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidBlindIndexMissingTarget) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *InvalidBlindIndexMissingTarget) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *InvalidBlindIndexMissingTarget) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidBlindIndexMissingTarget) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidBlindIndexMissingTarget) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidBlindIndexMissingTarget) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidBlindIndexMissingTarget_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+}
+
+func (b0 InvalidBlindIndexMissingTarget_builder) Build() *InvalidBlindIndexMissingTarget {
+	m0 := &InvalidBlindIndexMissingTarget{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidBlindIndexPersonalDataTarget struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	xxx_hidden_Data2       *string                `protobuf:"bytes,3,opt,name=data2"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidBlindIndexPersonalDataTarget) Reset() {
+	*x = InvalidBlindIndexPersonalDataTarget{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidBlindIndexPersonalDataTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidBlindIndexPersonalDataTarget) ProtoMessage() {}
+
+func (x *InvalidBlindIndexPersonalDataTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidBlindIndexPersonalDataTarget) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidBlindIndexPersonalDataTarget) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidBlindIndexPersonalDataTarget) GetData2() string {
+	if x != nil {
+		if x.xxx_hidden_Data2 != nil {
+			return *x.xxx_hidden_Data2
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidBlindIndexPersonalDataTarget) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *InvalidBlindIndexPersonalDataTarget) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *InvalidBlindIndexPersonalDataTarget) SetData2(v string) {
+	x.xxx_hidden_Data2 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *InvalidBlindIndexPersonalDataTarget) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidBlindIndexPersonalDataTarget) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidBlindIndexPersonalDataTarget) HasData2() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *InvalidBlindIndexPersonalDataTarget) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidBlindIndexPersonalDataTarget) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+func (x *InvalidBlindIndexPersonalDataTarget) ClearData2() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data2 = nil
+}
+
+type InvalidBlindIndexPersonalDataTarget_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+	Data2 *string
+}
+
+func (b0 InvalidBlindIndexPersonalDataTarget_builder) Build() *InvalidBlindIndexPersonalDataTarget {
+	m0 := &InvalidBlindIndexPersonalDataTarget{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	if b.Data2 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Data2 = b.Data2
+	}
+	return m0
+}
+
+type InvalidBlindIndexLength struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidBlindIndexLength) Reset() {
+	*x = InvalidBlindIndexLength{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidBlindIndexLength) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidBlindIndexLength) ProtoMessage() {}
+
+func (x *InvalidBlindIndexLength) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidBlindIndexLength) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidBlindIndexLength) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidBlindIndexLength) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *InvalidBlindIndexLength) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *InvalidBlindIndexLength) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidBlindIndexLength) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidBlindIndexLength) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidBlindIndexLength) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidBlindIndexLength_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+}
+
+func (b0 InvalidBlindIndexLength_builder) Build() *InvalidBlindIndexLength {
+	m0 := &InvalidBlindIndexLength{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidBlindIndexRepeated struct {
+	state                  protoimpl.MessageState            `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                           `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Items       *[]*InvalidBlindIndexRepeatedItem `protobuf:"bytes,2,rep,name=items"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidBlindIndexRepeated) Reset() {
+	*x = InvalidBlindIndexRepeated{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidBlindIndexRepeated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidBlindIndexRepeated) ProtoMessage() {}
+
+func (x *InvalidBlindIndexRepeated) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidBlindIndexRepeated) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidBlindIndexRepeated) GetItems() []*InvalidBlindIndexRepeatedItem {
+	if x != nil {
+		if x.xxx_hidden_Items != nil {
+			return *x.xxx_hidden_Items
+		}
+	}
+	return nil
+}
+
+func (x *InvalidBlindIndexRepeated) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *InvalidBlindIndexRepeated) SetItems(v []*InvalidBlindIndexRepeatedItem) {
+	x.xxx_hidden_Items = &v
+}
+
+func (x *InvalidBlindIndexRepeated) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidBlindIndexRepeated) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type InvalidBlindIndexRepeated_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Items []*InvalidBlindIndexRepeatedItem
+}
+
+func (b0 InvalidBlindIndexRepeated_builder) Build() *InvalidBlindIndexRepeated {
+	m0 := &InvalidBlindIndexRepeated{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_Items = &b.Items
+	return m0
+}
+
+type InvalidBlindIndexRepeatedItem struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,1,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidBlindIndexRepeatedItem) Reset() {
+	*x = InvalidBlindIndexRepeatedItem{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidBlindIndexRepeatedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidBlindIndexRepeatedItem) ProtoMessage() {}
+
+func (x *InvalidBlindIndexRepeatedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidBlindIndexRepeatedItem) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidBlindIndexRepeatedItem) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *InvalidBlindIndexRepeatedItem) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidBlindIndexRepeatedItem) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidBlindIndexRepeatedItem_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Data1 *string
+}
+
+func (b0 InvalidBlindIndexRepeatedItem_builder) Build() *InvalidBlindIndexRepeatedItem {
+	m0 := &InvalidBlindIndexRepeatedItem{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidCategoryAndCustomCategory struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidCategoryAndCustomCategory) Reset() {
+	*x = InvalidCategoryAndCustomCategory{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidCategoryAndCustomCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidCategoryAndCustomCategory) ProtoMessage() {}
+
+func (x *InvalidCategoryAndCustomCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidCategoryAndCustomCategory) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidCategoryAndCustomCategory) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidCategoryAndCustomCategory) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *InvalidCategoryAndCustomCategory) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *InvalidCategoryAndCustomCategory) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidCategoryAndCustomCategory) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidCategoryAndCustomCategory) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidCategoryAndCustomCategory) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidCategoryAndCustomCategory_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+}
+
+func (b0 InvalidCategoryAndCustomCategory_builder) Build() *InvalidCategoryAndCustomCategory {
+	m0 := &InvalidCategoryAndCustomCategory{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidConditionSyntax struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidConditionSyntax) Reset() {
+	*x = InvalidConditionSyntax{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidConditionSyntax) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidConditionSyntax) ProtoMessage() {}
+
+func (x *InvalidConditionSyntax) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidConditionSyntax) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidConditionSyntax) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidConditionSyntax) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *InvalidConditionSyntax) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *InvalidConditionSyntax) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidConditionSyntax) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidConditionSyntax) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidConditionSyntax) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidConditionSyntax_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+}
+
+func (b0 InvalidConditionSyntax_builder) Build() *InvalidConditionSyntax {
+	m0 := &InvalidConditionSyntax{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidConditionUnknownField struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidConditionUnknownField) Reset() {
+	*x = InvalidConditionUnknownField{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidConditionUnknownField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidConditionUnknownField) ProtoMessage() {}
+
+func (x *InvalidConditionUnknownField) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidConditionUnknownField) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidConditionUnknownField) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidConditionUnknownField) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *InvalidConditionUnknownField) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *InvalidConditionUnknownField) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidConditionUnknownField) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidConditionUnknownField) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidConditionUnknownField) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidConditionUnknownField_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+}
+
+func (b0 InvalidConditionUnknownField_builder) Build() *InvalidConditionUnknownField {
+	m0 := &InvalidConditionUnknownField{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidConditionNotBool struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidConditionNotBool) Reset() {
+	*x = InvalidConditionNotBool{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidConditionNotBool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidConditionNotBool) ProtoMessage() {}
+
+func (x *InvalidConditionNotBool) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidConditionNotBool) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidConditionNotBool) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidConditionNotBool) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *InvalidConditionNotBool) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *InvalidConditionNotBool) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidConditionNotBool) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidConditionNotBool) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidConditionNotBool) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidConditionNotBool_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+}
+
+func (b0 InvalidConditionNotBool_builder) Build() *InvalidConditionNotBool {
+	m0 := &InvalidConditionNotBool{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidConditionPersonalDataField struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Channel     *string                `protobuf:"bytes,2,opt,name=channel"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,3,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidConditionPersonalDataField) Reset() {
+	*x = InvalidConditionPersonalDataField{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidConditionPersonalDataField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidConditionPersonalDataField) ProtoMessage() {}
+
+func (x *InvalidConditionPersonalDataField) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidConditionPersonalDataField) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidConditionPersonalDataField) GetChannel() string {
+	if x != nil {
+		if x.xxx_hidden_Channel != nil {
+			return *x.xxx_hidden_Channel
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidConditionPersonalDataField) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidConditionPersonalDataField) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *InvalidConditionPersonalDataField) SetChannel(v string) {
+	x.xxx_hidden_Channel = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *InvalidConditionPersonalDataField) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *InvalidConditionPersonalDataField) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidConditionPersonalDataField) HasChannel() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidConditionPersonalDataField) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *InvalidConditionPersonalDataField) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidConditionPersonalDataField) ClearChannel() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Channel = nil
+}
+
+func (x *InvalidConditionPersonalDataField) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidConditionPersonalDataField_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id      *string
+	Channel *string
+	Data1   *string
+}
+
+func (b0 InvalidConditionPersonalDataField_builder) Build() *InvalidConditionPersonalDataField {
+	m0 := &InvalidConditionPersonalDataField{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Channel != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Channel = b.Channel
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidConditionNestedPersonalDataField struct {
+	state                  protoimpl.MessageState                           `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                                          `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Profile     *InvalidConditionNestedPersonalDataField_Profile `protobuf:"bytes,2,opt,name=profile"`
+	xxx_hidden_Data1       *string                                          `protobuf:"bytes,3,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidConditionNestedPersonalDataField) Reset() {
+	*x = InvalidConditionNestedPersonalDataField{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidConditionNestedPersonalDataField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidConditionNestedPersonalDataField) ProtoMessage() {}
+
+func (x *InvalidConditionNestedPersonalDataField) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidConditionNestedPersonalDataField) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidConditionNestedPersonalDataField) GetProfile() *InvalidConditionNestedPersonalDataField_Profile {
+	if x != nil {
+		return x.xxx_hidden_Profile
+	}
+	return nil
+}
+
+func (x *InvalidConditionNestedPersonalDataField) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidConditionNestedPersonalDataField) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *InvalidConditionNestedPersonalDataField) SetProfile(v *InvalidConditionNestedPersonalDataField_Profile) {
+	x.xxx_hidden_Profile = v
+}
+
+func (x *InvalidConditionNestedPersonalDataField) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *InvalidConditionNestedPersonalDataField) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidConditionNestedPersonalDataField) HasProfile() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Profile != nil
+}
+
+func (x *InvalidConditionNestedPersonalDataField) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *InvalidConditionNestedPersonalDataField) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidConditionNestedPersonalDataField) ClearProfile() {
+	x.xxx_hidden_Profile = nil
+}
+
+func (x *InvalidConditionNestedPersonalDataField) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidConditionNestedPersonalDataField_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id      *string
+	Profile *InvalidConditionNestedPersonalDataField_Profile
+	Data1   *string
+}
+
+func (b0 InvalidConditionNestedPersonalDataField_builder) Build() *InvalidConditionNestedPersonalDataField {
+	m0 := &InvalidConditionNestedPersonalDataField{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_Profile = b.Profile
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidConditionBlindIndexTarget struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Email       *string                `protobuf:"bytes,2,opt,name=email"`
+	xxx_hidden_EmailIndex  *string                `protobuf:"bytes,3,opt,name=email_index,json=emailIndex"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,4,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidConditionBlindIndexTarget) Reset() {
+	*x = InvalidConditionBlindIndexTarget{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidConditionBlindIndexTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidConditionBlindIndexTarget) ProtoMessage() {}
+
+func (x *InvalidConditionBlindIndexTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidConditionBlindIndexTarget) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidConditionBlindIndexTarget) GetEmail() string {
+	if x != nil {
+		if x.xxx_hidden_Email != nil {
+			return *x.xxx_hidden_Email
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidConditionBlindIndexTarget) GetEmailIndex() string {
+	if x != nil {
+		if x.xxx_hidden_EmailIndex != nil {
+			return *x.xxx_hidden_EmailIndex
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidConditionBlindIndexTarget) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidConditionBlindIndexTarget) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *InvalidConditionBlindIndexTarget) SetEmail(v string) {
+	x.xxx_hidden_Email = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *InvalidConditionBlindIndexTarget) SetEmailIndex(v string) {
+	x.xxx_hidden_EmailIndex = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *InvalidConditionBlindIndexTarget) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *InvalidConditionBlindIndexTarget) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidConditionBlindIndexTarget) HasEmail() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidConditionBlindIndexTarget) HasEmailIndex() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *InvalidConditionBlindIndexTarget) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *InvalidConditionBlindIndexTarget) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidConditionBlindIndexTarget) ClearEmail() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Email = nil
+}
+
+func (x *InvalidConditionBlindIndexTarget) ClearEmailIndex() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_EmailIndex = nil
+}
+
+func (x *InvalidConditionBlindIndexTarget) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidConditionBlindIndexTarget_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id         *string
+	Email      *string
+	EmailIndex *string
+	Data1      *string
+}
+
+func (b0 InvalidConditionBlindIndexTarget_builder) Build() *InvalidConditionBlindIndexTarget {
+	m0 := &InvalidConditionBlindIndexTarget{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Email != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Email = b.Email
+	}
+	if b.EmailIndex != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_EmailIndex = b.EmailIndex
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidRetentionWithoutTimestampField struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidRetentionWithoutTimestampField) Reset() {
+	*x = InvalidRetentionWithoutTimestampField{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidRetentionWithoutTimestampField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidRetentionWithoutTimestampField) ProtoMessage() {}
+
+func (x *InvalidRetentionWithoutTimestampField) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidRetentionWithoutTimestampField) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidRetentionWithoutTimestampField) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidRetentionWithoutTimestampField) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *InvalidRetentionWithoutTimestampField) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *InvalidRetentionWithoutTimestampField) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidRetentionWithoutTimestampField) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidRetentionWithoutTimestampField) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidRetentionWithoutTimestampField) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidRetentionWithoutTimestampField_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+}
+
+func (b0 InvalidRetentionWithoutTimestampField_builder) Build() *InvalidRetentionWithoutTimestampField {
+	m0 := &InvalidRetentionWithoutTimestampField{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidRetentionTimestampFieldType struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_CreatedAt   *string                `protobuf:"bytes,2,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,3,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidRetentionTimestampFieldType) Reset() {
+	*x = InvalidRetentionTimestampFieldType{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidRetentionTimestampFieldType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidRetentionTimestampFieldType) ProtoMessage() {}
+
+func (x *InvalidRetentionTimestampFieldType) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidRetentionTimestampFieldType) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidRetentionTimestampFieldType) GetCreatedAt() string {
+	if x != nil {
+		if x.xxx_hidden_CreatedAt != nil {
+			return *x.xxx_hidden_CreatedAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidRetentionTimestampFieldType) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidRetentionTimestampFieldType) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *InvalidRetentionTimestampFieldType) SetCreatedAt(v string) {
+	x.xxx_hidden_CreatedAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *InvalidRetentionTimestampFieldType) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *InvalidRetentionTimestampFieldType) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidRetentionTimestampFieldType) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidRetentionTimestampFieldType) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *InvalidRetentionTimestampFieldType) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidRetentionTimestampFieldType) ClearCreatedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *InvalidRetentionTimestampFieldType) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidRetentionTimestampFieldType_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        *string
+	CreatedAt *string
+	Data1     *string
+}
+
+func (b0 InvalidRetentionTimestampFieldType_builder) Build() *InvalidRetentionTimestampFieldType {
+	m0 := &InvalidRetentionTimestampFieldType{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.CreatedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_CreatedAt = b.CreatedAt
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidRetentionTimestampFieldPersonalData struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,3,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidRetentionTimestampFieldPersonalData) Reset() {
+	*x = InvalidRetentionTimestampFieldPersonalData{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidRetentionTimestampFieldPersonalData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidRetentionTimestampFieldPersonalData) ProtoMessage() {}
+
+func (x *InvalidRetentionTimestampFieldPersonalData) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidRetentionTimestampFieldPersonalData) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidRetentionTimestampFieldPersonalData) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *InvalidRetentionTimestampFieldPersonalData) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidRetentionTimestampFieldPersonalData) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *InvalidRetentionTimestampFieldPersonalData) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *InvalidRetentionTimestampFieldPersonalData) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *InvalidRetentionTimestampFieldPersonalData) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidRetentionTimestampFieldPersonalData) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *InvalidRetentionTimestampFieldPersonalData) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *InvalidRetentionTimestampFieldPersonalData) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidRetentionTimestampFieldPersonalData) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *InvalidRetentionTimestampFieldPersonalData) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidRetentionTimestampFieldPersonalData_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        *string
+	CreatedAt *timestamppb.Timestamp
+	Data1     *string
+}
+
+func (b0 InvalidRetentionTimestampFieldPersonalData_builder) Build() *InvalidRetentionTimestampFieldPersonalData {
+	m0 := &InvalidRetentionTimestampFieldPersonalData{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidRetentionNotPositive struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,3,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidRetentionNotPositive) Reset() {
+	*x = InvalidRetentionNotPositive{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidRetentionNotPositive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidRetentionNotPositive) ProtoMessage() {}
+
+func (x *InvalidRetentionNotPositive) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidRetentionNotPositive) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidRetentionNotPositive) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *InvalidRetentionNotPositive) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidRetentionNotPositive) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *InvalidRetentionNotPositive) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *InvalidRetentionNotPositive) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *InvalidRetentionNotPositive) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidRetentionNotPositive) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *InvalidRetentionNotPositive) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *InvalidRetentionNotPositive) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidRetentionNotPositive) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *InvalidRetentionNotPositive) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidRetentionNotPositive_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        *string
+	CreatedAt *timestamppb.Timestamp
+	Data1     *string
+}
+
+func (b0 InvalidRetentionNotPositive_builder) Build() *InvalidRetentionNotPositive {
+	m0 := &InvalidRetentionNotPositive{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidKeyBucketTimestampFieldWithoutKeyBucket struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,3,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidKeyBucketTimestampFieldWithoutKeyBucket) Reset() {
+	*x = InvalidKeyBucketTimestampFieldWithoutKeyBucket{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidKeyBucketTimestampFieldWithoutKeyBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidKeyBucketTimestampFieldWithoutKeyBucket) ProtoMessage() {}
+
+func (x *InvalidKeyBucketTimestampFieldWithoutKeyBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidKeyBucketTimestampFieldWithoutKeyBucket) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidKeyBucketTimestampFieldWithoutKeyBucket) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *InvalidKeyBucketTimestampFieldWithoutKeyBucket) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidKeyBucketTimestampFieldWithoutKeyBucket) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *InvalidKeyBucketTimestampFieldWithoutKeyBucket) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *InvalidKeyBucketTimestampFieldWithoutKeyBucket) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *InvalidKeyBucketTimestampFieldWithoutKeyBucket) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidKeyBucketTimestampFieldWithoutKeyBucket) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *InvalidKeyBucketTimestampFieldWithoutKeyBucket) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *InvalidKeyBucketTimestampFieldWithoutKeyBucket) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidKeyBucketTimestampFieldWithoutKeyBucket) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *InvalidKeyBucketTimestampFieldWithoutKeyBucket) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidKeyBucketTimestampFieldWithoutKeyBucket_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        *string
+	CreatedAt *timestamppb.Timestamp
+	Data1     *string
+}
+
+func (b0 InvalidKeyBucketTimestampFieldWithoutKeyBucket_builder) Build() *InvalidKeyBucketTimestampFieldWithoutKeyBucket {
+	m0 := &InvalidKeyBucketTimestampFieldWithoutKeyBucket{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidKeyBucketMissingTimestampField struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidKeyBucketMissingTimestampField) Reset() {
+	*x = InvalidKeyBucketMissingTimestampField{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidKeyBucketMissingTimestampField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidKeyBucketMissingTimestampField) ProtoMessage() {}
+
+func (x *InvalidKeyBucketMissingTimestampField) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidKeyBucketMissingTimestampField) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidKeyBucketMissingTimestampField) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidKeyBucketMissingTimestampField) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *InvalidKeyBucketMissingTimestampField) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *InvalidKeyBucketMissingTimestampField) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidKeyBucketMissingTimestampField) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidKeyBucketMissingTimestampField) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidKeyBucketMissingTimestampField) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidKeyBucketMissingTimestampField_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+}
+
+func (b0 InvalidKeyBucketMissingTimestampField_builder) Build() *InvalidKeyBucketMissingTimestampField {
+	m0 := &InvalidKeyBucketMissingTimestampField{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidKeyBucketTimestampFieldType struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_CreatedAt   *string                `protobuf:"bytes,2,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,3,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidKeyBucketTimestampFieldType) Reset() {
+	*x = InvalidKeyBucketTimestampFieldType{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidKeyBucketTimestampFieldType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidKeyBucketTimestampFieldType) ProtoMessage() {}
+
+func (x *InvalidKeyBucketTimestampFieldType) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidKeyBucketTimestampFieldType) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidKeyBucketTimestampFieldType) GetCreatedAt() string {
+	if x != nil {
+		if x.xxx_hidden_CreatedAt != nil {
+			return *x.xxx_hidden_CreatedAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidKeyBucketTimestampFieldType) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidKeyBucketTimestampFieldType) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *InvalidKeyBucketTimestampFieldType) SetCreatedAt(v string) {
+	x.xxx_hidden_CreatedAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *InvalidKeyBucketTimestampFieldType) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *InvalidKeyBucketTimestampFieldType) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidKeyBucketTimestampFieldType) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvalidKeyBucketTimestampFieldType) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *InvalidKeyBucketTimestampFieldType) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidKeyBucketTimestampFieldType) ClearCreatedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *InvalidKeyBucketTimestampFieldType) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidKeyBucketTimestampFieldType_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        *string
+	CreatedAt *string
+	Data1     *string
+}
+
+func (b0 InvalidKeyBucketTimestampFieldType_builder) Build() *InvalidKeyBucketTimestampFieldType {
+	m0 := &InvalidKeyBucketTimestampFieldType{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.CreatedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_CreatedAt = b.CreatedAt
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type InvalidKeyBucketTimestampFieldPersonalData struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,3,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidKeyBucketTimestampFieldPersonalData) Reset() {
+	*x = InvalidKeyBucketTimestampFieldPersonalData{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidKeyBucketTimestampFieldPersonalData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidKeyBucketTimestampFieldPersonalData) ProtoMessage() {}
+
+func (x *InvalidKeyBucketTimestampFieldPersonalData) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidKeyBucketTimestampFieldPersonalData) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidKeyBucketTimestampFieldPersonalData) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *InvalidKeyBucketTimestampFieldPersonalData) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidKeyBucketTimestampFieldPersonalData) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *InvalidKeyBucketTimestampFieldPersonalData) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *InvalidKeyBucketTimestampFieldPersonalData) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *InvalidKeyBucketTimestampFieldPersonalData) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidKeyBucketTimestampFieldPersonalData) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *InvalidKeyBucketTimestampFieldPersonalData) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *InvalidKeyBucketTimestampFieldPersonalData) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *InvalidKeyBucketTimestampFieldPersonalData) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *InvalidKeyBucketTimestampFieldPersonalData) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data1 = nil
+}
+
+type InvalidKeyBucketTimestampFieldPersonalData_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        *string
+	CreatedAt *timestamppb.Timestamp
+	Data1     *string
+}
+
+func (b0 InvalidKeyBucketTimestampFieldPersonalData_builder) Build() *InvalidKeyBucketTimestampFieldPersonalData {
+	m0 := &InvalidKeyBucketTimestampFieldPersonalData{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
 type InvalidMultipleDataSubjectIDsWithNesting_Nested struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
@@ -2074,7 +5212,7 @@ type InvalidMultipleDataSubjectIDsWithNesting_Nested struct {
 
 func (x *InvalidMultipleDataSubjectIDsWithNesting_Nested) Reset() {
 	*x = InvalidMultipleDataSubjectIDsWithNesting_Nested{}
-	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[14]
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2086,7 +5224,7 @@ func (x *InvalidMultipleDataSubjectIDsWithNesting_Nested) String() string {
 func (*InvalidMultipleDataSubjectIDsWithNesting_Nested) ProtoMessage() {}
 
 func (x *InvalidMultipleDataSubjectIDsWithNesting_Nested) ProtoReflect() protoreflect.Message {
-	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[14]
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2186,7 +5324,7 @@ type InvalidMultipleDataSubjectIDsWithDeepNesting_Nested1 struct {
 
 func (x *InvalidMultipleDataSubjectIDsWithDeepNesting_Nested1) Reset() {
 	*x = InvalidMultipleDataSubjectIDsWithDeepNesting_Nested1{}
-	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[15]
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2198,7 +5336,7 @@ func (x *InvalidMultipleDataSubjectIDsWithDeepNesting_Nested1) String() string {
 func (*InvalidMultipleDataSubjectIDsWithDeepNesting_Nested1) ProtoMessage() {}
 
 func (x *InvalidMultipleDataSubjectIDsWithDeepNesting_Nested1) ProtoReflect() protoreflect.Message {
-	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[15]
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2321,7 +5459,7 @@ type InvalidMultipleDataSubjectIDsWithDeepNesting_Nested2 struct {
 
 func (x *InvalidMultipleDataSubjectIDsWithDeepNesting_Nested2) Reset() {
 	*x = InvalidMultipleDataSubjectIDsWithDeepNesting_Nested2{}
-	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[16]
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2333,7 +5471,7 @@ func (x *InvalidMultipleDataSubjectIDsWithDeepNesting_Nested2) String() string {
 func (*InvalidMultipleDataSubjectIDsWithDeepNesting_Nested2) ProtoMessage() {}
 
 func (x *InvalidMultipleDataSubjectIDsWithDeepNesting_Nested2) ProtoReflect() protoreflect.Message {
-	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[16]
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2432,7 +5570,7 @@ type InvalidDataSubjectIDMessage_Nested struct {
 
 func (x *InvalidDataSubjectIDMessage_Nested) Reset() {
 	*x = InvalidDataSubjectIDMessage_Nested{}
-	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[18]
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2444,7 +5582,7 @@ func (x *InvalidDataSubjectIDMessage_Nested) String() string {
 func (*InvalidDataSubjectIDMessage_Nested) ProtoMessage() {}
 
 func (x *InvalidDataSubjectIDMessage_Nested) ProtoReflect() protoreflect.Message {
-	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[18]
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2543,7 +5681,7 @@ type InvalidDataSubjectIDNestedInRepeated_Nested struct {
 
 func (x *InvalidDataSubjectIDNestedInRepeated_Nested) Reset() {
 	*x = InvalidDataSubjectIDNestedInRepeated_Nested{}
-	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[19]
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2555,7 +5693,7 @@ func (x *InvalidDataSubjectIDNestedInRepeated_Nested) String() string {
 func (*InvalidDataSubjectIDNestedInRepeated_Nested) ProtoMessage() {}
 
 func (x *InvalidDataSubjectIDNestedInRepeated_Nested) ProtoReflect() protoreflect.Message {
-	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[19]
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2654,7 +5792,7 @@ type InvalidDataSubjectIDNestedInMap_Nested struct {
 
 func (x *InvalidDataSubjectIDNestedInMap_Nested) Reset() {
 	*x = InvalidDataSubjectIDNestedInMap_Nested{}
-	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[20]
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2666,7 +5804,7 @@ func (x *InvalidDataSubjectIDNestedInMap_Nested) String() string {
 func (*InvalidDataSubjectIDNestedInMap_Nested) ProtoMessage() {}
 
 func (x *InvalidDataSubjectIDNestedInMap_Nested) ProtoReflect() protoreflect.Message {
-	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[20]
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2765,7 +5903,7 @@ type InvalidDataSubjectIDInExternalNestedInRepeated_Nested struct {
 
 func (x *InvalidDataSubjectIDInExternalNestedInRepeated_Nested) Reset() {
 	*x = InvalidDataSubjectIDInExternalNestedInRepeated_Nested{}
-	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[22]
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2777,7 +5915,7 @@ func (x *InvalidDataSubjectIDInExternalNestedInRepeated_Nested) String() string
 func (*InvalidDataSubjectIDInExternalNestedInRepeated_Nested) ProtoMessage() {}
 
 func (x *InvalidDataSubjectIDInExternalNestedInRepeated_Nested) ProtoReflect() protoreflect.Message {
-	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[22]
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2868,7 +6006,7 @@ type InvalidDataSubjectIDInExternalNestedInMap_Nested struct {
 
 func (x *InvalidDataSubjectIDInExternalNestedInMap_Nested) Reset() {
 	*x = InvalidDataSubjectIDInExternalNestedInMap_Nested{}
-	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[23]
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2880,7 +6018,7 @@ func (x *InvalidDataSubjectIDInExternalNestedInMap_Nested) String() string {
 func (*InvalidDataSubjectIDInExternalNestedInMap_Nested) ProtoMessage() {}
 
 func (x *InvalidDataSubjectIDInExternalNestedInMap_Nested) ProtoReflect() protoreflect.Message {
-	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[23]
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2959,11 +6097,89 @@ func (b0 InvalidDataSubjectIDInExternalNestedInMap_Nested_builder) Build() *Inva
 	return m0
 }
 
+type InvalidConditionNestedPersonalDataField_Profile struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Channel     *string                `protobuf:"bytes,1,opt,name=channel"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidConditionNestedPersonalDataField_Profile) Reset() {
+	*x = InvalidConditionNestedPersonalDataField_Profile{}
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidConditionNestedPersonalDataField_Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidConditionNestedPersonalDataField_Profile) ProtoMessage() {}
+
+func (x *InvalidConditionNestedPersonalDataField_Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_invalid_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidConditionNestedPersonalDataField_Profile) GetChannel() string {
+	if x != nil {
+		if x.xxx_hidden_Channel != nil {
+			return *x.xxx_hidden_Channel
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidConditionNestedPersonalDataField_Profile) SetChannel(v string) {
+	x.xxx_hidden_Channel = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *InvalidConditionNestedPersonalDataField_Profile) HasChannel() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidConditionNestedPersonalDataField_Profile) ClearChannel() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Channel = nil
+}
+
+type InvalidConditionNestedPersonalDataField_Profile_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Channel *string
+}
+
+func (b0 InvalidConditionNestedPersonalDataField_Profile_builder) Build() *InvalidConditionNestedPersonalDataField_Profile {
+	m0 := &InvalidConditionNestedPersonalDataField_Profile{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Channel != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Channel = b.Channel
+	}
+	return m0
+}
+
 var File_boostport_privacy_testing_invalid_proto protoreflect.FileDescriptor
 
 const file_boostport_privacy_testing_invalid_proto_rawDesc = "" +
 	"\n" +
-	"'boostport/privacy/testing/invalid.proto\x12\x19boostport.privacy.testing\x1a\x1fboostport/privacy/privacy.proto\"\x86\x01\n" +
+	"'boostport/privacy/testing/invalid.proto\x12\x19boostport.privacy.testing\x1a\x1fboostport/privacy/privacy.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x86\x01\n" +
 	"\x1dInvalidMultipleDataSubjectIDs\x12\x15\n" +
 	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
 	"\x00R\x02id\x12\x1b\n" +
@@ -3078,10 +6294,152 @@ const file_boostport_privacy_testing_invalid_proto_rawDesc = "" +
 	"\x06data12\x18\r \x01(\x06B\v\x82}\b\x12\x06r\x04testR\x06data12\x12#\n" +
 	"\x06data13\x18\x0e \x01(\x01B\v\x82}\b\x12\x06r\x04testR\x06data13\x12\x1f\n" +
 	"\x06data14\x18\x0f \x01(\tB\a\x82}\x04\x12\x02\x18\x01R\x06data14\x12#\n" +
-	"\x06data15\x18\x10 \x01(\fB\v\x82}\b\x12\x06r\x04testR\x06data15B\x83\x02\n" +
+	"\x06data15\x18\x10 \x01(\fB\v\x82}\b\x12\x06r\x04testR\x06data15\"O\n" +
+	"\x14InvalidMaskNonString\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12 \n" +
+	"\x05data1\x18\x02 \x01(\x05B\n" +
+	"\x82}\a\x12\x05\x82\x01\x02\b\x04R\x05data1\"N\n" +
+	"\x13InvalidMaskRepeated\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12 \n" +
+	"\x05data1\x18\x02 \x03(\tB\n" +
+	"\x82}\a\x12\x05\x82\x01\x02\b\x04R\x05data1\"V\n" +
+	"\x19InvalidMaskCharacterEmpty\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12\"\n" +
+	"\x05data1\x18\x02 \x01(\tB\f\x82}\t\x12\a\x82\x01\x042\x00\b\x04R\x05data1\"[\n" +
+	"\x1cInvalidMaskCharacterMultiple\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12$\n" +
+	"\x05data1\x18\x02 \x01(\tB\x0e\x82}\v\x12\t\x82\x01\x062\x02**\b\x04R\x05data1\"U\n" +
+	"\x1cInvalidPseudonymizeNonString\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12\x1e\n" +
+	"\x05data1\x18\x02 \x01(\fB\b\x82}\x05\x12\x03\x8a\x01\x00R\x05data1\"Y\n" +
+	"\x1bInvalidPseudonymizeWithMask\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12#\n" +
+	"\x05data1\x18\x02 \x01(\tB\r\x82}\n" +
+	"\x12\b\x82\x01\x02\b\x04\x8a\x01\x00R\x05data1\"`\n" +
+	"\x1eInvalidBlindIndexMissingTarget\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12'\n" +
+	"\x05data1\x18\x02 \x01(\tB\x11\x82}\x0e\x12\f\x92\x01\t\n" +
+	"\amissingR\x05data1\"\x80\x01\n" +
+	"#InvalidBlindIndexPersonalDataTarget\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12%\n" +
+	"\x05data1\x18\x02 \x01(\tB\x0f\x82}\f\x12\n" +
+	"\x92\x01\a\n" +
+	"\x05data2R\x05data1\x12\x1b\n" +
+	"\x05data2\x18\x03 \x01(\tB\x05\x82}\x02\x12\x00R\x05data2\"R\n" +
+	"\x17InvalidBlindIndexLength\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12 \n" +
+	"\x05data1\x18\x02 \x01(\tB\n" +
+	"\x82}\a\x12\x05\x92\x01\x02\x10!R\x05data1\"\x82\x01\n" +
+	"\x19InvalidBlindIndexRepeated\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12N\n" +
+	"\x05items\x18\x02 \x03(\v28.boostport.privacy.testing.InvalidBlindIndexRepeatedItemR\x05items\"?\n" +
+	"\x1dInvalidBlindIndexRepeatedItem\x12\x1e\n" +
+	"\x05data1\x18\x01 \x01(\tB\b\x82}\x05\x12\x03\x92\x01\x00R\x05data1\"e\n" +
+	" InvalidCategoryAndCustomCategory\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12*\n" +
+	"\x05data1\x18\x02 \x01(\tB\x14\x82}\x11\x12\x0f\x98\x01\x01\xa2\x01\tmarketingR\x05data1\"T\n" +
+	"\x16InvalidConditionSyntax\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12#\n" +
+	"\x05data1\x18\x02 \x01(\tB\r\x82}\n" +
+	"\x12\b\xaa\x01\x05id ==R\x05data1\"j\n" +
+	"\x1cInvalidConditionUnknownField\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x123\n" +
+	"\x05data1\x18\x02 \x01(\tB\x1d\x82}\x1a\x12\x18\xaa\x01\x15channel == \"customer\"R\x05data1\"R\n" +
+	"\x17InvalidConditionNotBool\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12 \n" +
+	"\x05data1\x18\x02 \x01(\tB\n" +
+	"\x82}\a\x12\x05\xaa\x01\x02idR\x05data1\"\x90\x01\n" +
+	"!InvalidConditionPersonalDataField\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12\x1f\n" +
+	"\achannel\x18\x02 \x01(\tB\x05\x82}\x02\x12\x00R\achannel\x123\n" +
+	"\x05data1\x18\x03 \x01(\tB\x1d\x82}\x1a\x12\x18\xaa\x01\x15channel == \"customer\"R\x05data1\"\x8f\x02\n" +
+	"'InvalidConditionNestedPersonalDataField\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12d\n" +
+	"\aprofile\x18\x02 \x01(\v2J.boostport.privacy.testing.InvalidConditionNestedPersonalDataField.ProfileR\aprofile\x12;\n" +
+	"\x05data1\x18\x03 \x01(\tB%\x82}\"\x12 \xaa\x01\x1dprofile.channel == \"customer\"R\x05data1\x1a*\n" +
+	"\aProfile\x12\x1f\n" +
+	"\achannel\x18\x01 \x01(\tB\x05\x82}\x02\x12\x00R\achannel\"\xb8\x01\n" +
+	" InvalidConditionBlindIndexTarget\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12+\n" +
+	"\x05email\x18\x02 \x01(\tB\x15\x82}\x12\x12\x10\x92\x01\r\n" +
+	"\vemail_indexR\x05email\x12\x1f\n" +
+	"\vemail_index\x18\x03 \x01(\tR\n" +
+	"emailIndex\x12/\n" +
+	"\x05data1\x18\x04 \x01(\tB\x19\x82}\x16\x12\x14\xaa\x01\x11email_index != \"\"R\x05data1\"b\n" +
+	"%InvalidRetentionWithoutTimestampField\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12\"\n" +
+	"\x05data1\x18\x02 \x01(\tB\f\x82}\t\x12\a\xb2\x01\x04\b\x80\xa3\x05R\x05data1\"\x8f\x01\n" +
+	"\"InvalidRetentionTimestampFieldType\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\"\n" +
+	"\x05data1\x18\x03 \x01(\tB\f\x82}\t\x12\a\xb2\x01\x04\b\x80\xa3\x05R\x05data1:\x0f\x82}\f\n" +
+	"\n" +
+	"created_at\"\xba\x01\n" +
+	"*InvalidRetentionTimestampFieldPersonalData\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12@\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x05\x82}\x02\x12\x00R\tcreatedAt\x12\"\n" +
+	"\x05data1\x18\x03 \x01(\tB\f\x82}\t\x12\a\xb2\x01\x04\b\x80\xa3\x05R\x05data1:\x0f\x82}\f\n" +
+	"\n" +
+	"created_at\"\xab\x01\n" +
+	"\x1bInvalidRetentionNotPositive\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12)\n" +
+	"\x05data1\x18\x03 \x01(\tB\x13\x82}\x10\x12\x0e\xb2\x01\v\b\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01R\x05data1:\x0f\x82}\f\n" +
+	"\n" +
+	"created_at\"\xab\x01\n" +
+	".InvalidKeyBucketTimestampFieldWithoutKeyBucket\x12!\n" +
+	"\x02id\x18\x01 \x01(\tB\x11\x82}\x0e\n" +
+	"\f\x1a\n" +
+	"created_atR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\x05data1\x18\x03 \x01(\tB\x05\x82}\x02\x12\x00R\x05data1\"i\n" +
+	"%InvalidKeyBucketMissingTimestampField\x12#\n" +
+	"\x02id\x18\x01 \x01(\tB\x13\x82}\x10\n" +
+	"\x0e\x10\x01\x1a\n" +
+	"created_atR\x02id\x12\x1b\n" +
+	"\x05data1\x18\x02 \x01(\tB\x05\x82}\x02\x12\x00R\x05data1\"\x85\x01\n" +
+	"\"InvalidKeyBucketTimestampFieldType\x12#\n" +
+	"\x02id\x18\x01 \x01(\tB\x13\x82}\x10\n" +
+	"\x0e\x10\x01\x1a\n" +
+	"created_atR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\x05data1\x18\x03 \x01(\tB\x05\x82}\x02\x12\x00R\x05data1\"\xb0\x01\n" +
+	"*InvalidKeyBucketTimestampFieldPersonalData\x12#\n" +
+	"\x02id\x18\x01 \x01(\tB\x13\x82}\x10\n" +
+	"\x0e\x10\x01\x1a\n" +
+	"created_atR\x02id\x12@\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x05\x82}\x02\x12\x00R\tcreatedAt\x12\x1b\n" +
+	"\x05data1\x18\x03 \x01(\tB\x05\x82}\x02\x12\x00R\x05data1B\x83\x02\n" +
 	"\x1dcom.boostport.privacy.testingB\fInvalidProtoP\x01ZNgithub.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing\xa2\x02\x03BPT\xaa\x02\x19Boostport.Privacy.Testing\xca\x02\x19Boostport\\Privacy\\Testing\xe2\x02%Boostport\\Privacy\\Testing\\GPBMetadata\xea\x02\x1bBoostport::Privacy::Testingb\beditionsp\xe8\a"
 
-var file_boostport_privacy_testing_invalid_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_boostport_privacy_testing_invalid_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_boostport_privacy_testing_invalid_proto_goTypes = []any{
 	(*InvalidMultipleDataSubjectIDs)(nil),                        // 0: boostport.privacy.testing.InvalidMultipleDataSubjectIDs
 	(*InvalidMultipleDataSubjectIDsWithNesting)(nil),             // 1: boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithNesting
@@ -3097,37 +6455,71 @@ var file_boostport_privacy_testing_invalid_proto_goTypes = []any{
 	(*InvalidDataSubjectIDInExternalNestedInMap)(nil),            // 11: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInMap
 	(*InvalidNoPersonalDataField)(nil),                           // 12: boostport.privacy.testing.InvalidNoPersonalDataField
 	(*InvalidFallbackTypes)(nil),                                 // 13: boostport.privacy.testing.InvalidFallbackTypes
-	(*InvalidMultipleDataSubjectIDsWithNesting_Nested)(nil),      // 14: boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithNesting.Nested
-	(*InvalidMultipleDataSubjectIDsWithDeepNesting_Nested1)(nil), // 15: boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithDeepNesting.Nested1
-	(*InvalidMultipleDataSubjectIDsWithDeepNesting_Nested2)(nil), // 16: boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithDeepNesting.Nested2
-	nil, // 17: boostport.privacy.testing.InvalidDataSubjectIDMap.IdEntry
-	(*InvalidDataSubjectIDMessage_Nested)(nil),          // 18: boostport.privacy.testing.InvalidDataSubjectIDMessage.Nested
-	(*InvalidDataSubjectIDNestedInRepeated_Nested)(nil), // 19: boostport.privacy.testing.InvalidDataSubjectIDNestedInRepeated.Nested
-	(*InvalidDataSubjectIDNestedInMap_Nested)(nil),      // 20: boostport.privacy.testing.InvalidDataSubjectIDNestedInMap.Nested
-	nil, // 21: boostport.privacy.testing.InvalidDataSubjectIDNestedInMap.IdEntry
-	(*InvalidDataSubjectIDInExternalNestedInRepeated_Nested)(nil), // 22: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInRepeated.Nested
-	(*InvalidDataSubjectIDInExternalNestedInMap_Nested)(nil),      // 23: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInMap.Nested
-	nil, // 24: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInMap.IdEntry
+	(*InvalidMaskNonString)(nil),                                 // 14: boostport.privacy.testing.InvalidMaskNonString
+	(*InvalidMaskRepeated)(nil),                                  // 15: boostport.privacy.testing.InvalidMaskRepeated
+	(*InvalidMaskCharacterEmpty)(nil),                            // 16: boostport.privacy.testing.InvalidMaskCharacterEmpty
+	(*InvalidMaskCharacterMultiple)(nil),                         // 17: boostport.privacy.testing.InvalidMaskCharacterMultiple
+	(*InvalidPseudonymizeNonString)(nil),                         // 18: boostport.privacy.testing.InvalidPseudonymizeNonString
+	(*InvalidPseudonymizeWithMask)(nil),                          // 19: boostport.privacy.testing.InvalidPseudonymizeWithMask
+	(*InvalidBlindIndexMissingTarget)(nil),                       // 20: boostport.privacy.testing.InvalidBlindIndexMissingTarget
+	(*InvalidBlindIndexPersonalDataTarget)(nil),                  // 21: boostport.privacy.testing.InvalidBlindIndexPersonalDataTarget
+	(*InvalidBlindIndexLength)(nil),                              // 22: boostport.privacy.testing.InvalidBlindIndexLength
+	(*InvalidBlindIndexRepeated)(nil),                            // 23: boostport.privacy.testing.InvalidBlindIndexRepeated
+	(*InvalidBlindIndexRepeatedItem)(nil),                        // 24: boostport.privacy.testing.InvalidBlindIndexRepeatedItem
+	(*InvalidCategoryAndCustomCategory)(nil),                     // 25: boostport.privacy.testing.InvalidCategoryAndCustomCategory
+	(*InvalidConditionSyntax)(nil),                               // 26: boostport.privacy.testing.InvalidConditionSyntax
+	(*InvalidConditionUnknownField)(nil),                         // 27: boostport.privacy.testing.InvalidConditionUnknownField
+	(*InvalidConditionNotBool)(nil),                              // 28: boostport.privacy.testing.InvalidConditionNotBool
+	(*InvalidConditionPersonalDataField)(nil),                    // 29: boostport.privacy.testing.InvalidConditionPersonalDataField
+	(*InvalidConditionNestedPersonalDataField)(nil),              // 30: boostport.privacy.testing.InvalidConditionNestedPersonalDataField
+	(*InvalidConditionBlindIndexTarget)(nil),                     // 31: boostport.privacy.testing.InvalidConditionBlindIndexTarget
+	(*InvalidRetentionWithoutTimestampField)(nil),                // 32: boostport.privacy.testing.InvalidRetentionWithoutTimestampField
+	(*InvalidRetentionTimestampFieldType)(nil),                   // 33: boostport.privacy.testing.InvalidRetentionTimestampFieldType
+	(*InvalidRetentionTimestampFieldPersonalData)(nil),           // 34: boostport.privacy.testing.InvalidRetentionTimestampFieldPersonalData
+	(*InvalidRetentionNotPositive)(nil),                          // 35: boostport.privacy.testing.InvalidRetentionNotPositive
+	(*InvalidKeyBucketTimestampFieldWithoutKeyBucket)(nil),       // 36: boostport.privacy.testing.InvalidKeyBucketTimestampFieldWithoutKeyBucket
+	(*InvalidKeyBucketMissingTimestampField)(nil),                // 37: boostport.privacy.testing.InvalidKeyBucketMissingTimestampField
+	(*InvalidKeyBucketTimestampFieldType)(nil),                   // 38: boostport.privacy.testing.InvalidKeyBucketTimestampFieldType
+	(*InvalidKeyBucketTimestampFieldPersonalData)(nil),           // 39: boostport.privacy.testing.InvalidKeyBucketTimestampFieldPersonalData
+	(*InvalidMultipleDataSubjectIDsWithNesting_Nested)(nil),      // 40: boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithNesting.Nested
+	(*InvalidMultipleDataSubjectIDsWithDeepNesting_Nested1)(nil), // 41: boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithDeepNesting.Nested1
+	(*InvalidMultipleDataSubjectIDsWithDeepNesting_Nested2)(nil), // 42: boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithDeepNesting.Nested2
+	nil, // 43: boostport.privacy.testing.InvalidDataSubjectIDMap.IdEntry
+	(*InvalidDataSubjectIDMessage_Nested)(nil),          // 44: boostport.privacy.testing.InvalidDataSubjectIDMessage.Nested
+	(*InvalidDataSubjectIDNestedInRepeated_Nested)(nil), // 45: boostport.privacy.testing.InvalidDataSubjectIDNestedInRepeated.Nested
+	(*InvalidDataSubjectIDNestedInMap_Nested)(nil),      // 46: boostport.privacy.testing.InvalidDataSubjectIDNestedInMap.Nested
+	nil, // 47: boostport.privacy.testing.InvalidDataSubjectIDNestedInMap.IdEntry
+	(*InvalidDataSubjectIDInExternalNestedInRepeated_Nested)(nil), // 48: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInRepeated.Nested
+	(*InvalidDataSubjectIDInExternalNestedInMap_Nested)(nil),      // 49: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInMap.Nested
+	nil, // 50: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInMap.IdEntry
+	(*InvalidConditionNestedPersonalDataField_Profile)(nil), // 51: boostport.privacy.testing.InvalidConditionNestedPersonalDataField.Profile
+	(*timestamppb.Timestamp)(nil),                           // 52: google.protobuf.Timestamp
 }
 var file_boostport_privacy_testing_invalid_proto_depIdxs = []int32{
-	14, // 0: boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithNesting.data2:type_name -> boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithNesting.Nested
-	15, // 1: boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithDeepNesting.data2:type_name -> boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithDeepNesting.Nested1
-	17, // 2: boostport.privacy.testing.InvalidDataSubjectIDMap.id:type_name -> boostport.privacy.testing.InvalidDataSubjectIDMap.IdEntry
-	18, // 3: boostport.privacy.testing.InvalidDataSubjectIDMessage.id:type_name -> boostport.privacy.testing.InvalidDataSubjectIDMessage.Nested
-	19, // 4: boostport.privacy.testing.InvalidDataSubjectIDNestedInRepeated.id:type_name -> boostport.privacy.testing.InvalidDataSubjectIDNestedInRepeated.Nested
-	21, // 5: boostport.privacy.testing.InvalidDataSubjectIDNestedInMap.id:type_name -> boostport.privacy.testing.InvalidDataSubjectIDNestedInMap.IdEntry
-	22, // 6: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInRepeated.id:type_name -> boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInRepeated.Nested
-	24, // 7: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInMap.id:type_name -> boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInMap.IdEntry
-	16, // 8: boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithDeepNesting.Nested1.data2:type_name -> boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithDeepNesting.Nested2
-	20, // 9: boostport.privacy.testing.InvalidDataSubjectIDNestedInMap.IdEntry.value:type_name -> boostport.privacy.testing.InvalidDataSubjectIDNestedInMap.Nested
-	9,  // 10: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInRepeated.Nested.data1:type_name -> boostport.privacy.testing.InvalidExternalDataSubjectID
-	9,  // 11: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInMap.Nested.data1:type_name -> boostport.privacy.testing.InvalidExternalDataSubjectID
-	23, // 12: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInMap.IdEntry.value:type_name -> boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInMap.Nested
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	40, // 0: boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithNesting.data2:type_name -> boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithNesting.Nested
+	41, // 1: boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithDeepNesting.data2:type_name -> boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithDeepNesting.Nested1
+	43, // 2: boostport.privacy.testing.InvalidDataSubjectIDMap.id:type_name -> boostport.privacy.testing.InvalidDataSubjectIDMap.IdEntry
+	44, // 3: boostport.privacy.testing.InvalidDataSubjectIDMessage.id:type_name -> boostport.privacy.testing.InvalidDataSubjectIDMessage.Nested
+	45, // 4: boostport.privacy.testing.InvalidDataSubjectIDNestedInRepeated.id:type_name -> boostport.privacy.testing.InvalidDataSubjectIDNestedInRepeated.Nested
+	47, // 5: boostport.privacy.testing.InvalidDataSubjectIDNestedInMap.id:type_name -> boostport.privacy.testing.InvalidDataSubjectIDNestedInMap.IdEntry
+	48, // 6: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInRepeated.id:type_name -> boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInRepeated.Nested
+	50, // 7: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInMap.id:type_name -> boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInMap.IdEntry
+	24, // 8: boostport.privacy.testing.InvalidBlindIndexRepeated.items:type_name -> boostport.privacy.testing.InvalidBlindIndexRepeatedItem
+	51, // 9: boostport.privacy.testing.InvalidConditionNestedPersonalDataField.profile:type_name -> boostport.privacy.testing.InvalidConditionNestedPersonalDataField.Profile
+	52, // 10: boostport.privacy.testing.InvalidRetentionTimestampFieldPersonalData.created_at:type_name -> google.protobuf.Timestamp
+	52, // 11: boostport.privacy.testing.InvalidRetentionNotPositive.created_at:type_name -> google.protobuf.Timestamp
+	52, // 12: boostport.privacy.testing.InvalidKeyBucketTimestampFieldWithoutKeyBucket.created_at:type_name -> google.protobuf.Timestamp
+	52, // 13: boostport.privacy.testing.InvalidKeyBucketTimestampFieldPersonalData.created_at:type_name -> google.protobuf.Timestamp
+	42, // 14: boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithDeepNesting.Nested1.data2:type_name -> boostport.privacy.testing.InvalidMultipleDataSubjectIDsWithDeepNesting.Nested2
+	46, // 15: boostport.privacy.testing.InvalidDataSubjectIDNestedInMap.IdEntry.value:type_name -> boostport.privacy.testing.InvalidDataSubjectIDNestedInMap.Nested
+	9,  // 16: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInRepeated.Nested.data1:type_name -> boostport.privacy.testing.InvalidExternalDataSubjectID
+	9,  // 17: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInMap.Nested.data1:type_name -> boostport.privacy.testing.InvalidExternalDataSubjectID
+	49, // 18: boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInMap.IdEntry.value:type_name -> boostport.privacy.testing.InvalidDataSubjectIDInExternalNestedInMap.Nested
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_boostport_privacy_testing_invalid_proto_init() }
@@ -3141,7 +6533,7 @@ func file_boostport_privacy_testing_invalid_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_boostport_privacy_testing_invalid_proto_rawDesc), len(file_boostport_privacy_testing_invalid_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
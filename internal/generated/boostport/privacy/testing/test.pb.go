@@ -10,6 +10,7 @@ import (
 	_ "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	unsafe "unsafe"
 )
@@ -1158,11 +1159,1888 @@ func (b0 TestFallbackTypes_builder) Build() *TestFallbackTypes {
 	return m0
 }
 
+type TestMask struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	xxx_hidden_Data2       *string                `protobuf:"bytes,3,opt,name=data2"`
+	xxx_hidden_Data3       *string                `protobuf:"bytes,4,opt,name=data3"`
+	xxx_hidden_Data4       *string                `protobuf:"bytes,5,opt,name=data4"`
+	xxx_hidden_Data5       *string                `protobuf:"bytes,6,opt,name=data5"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TestMask) Reset() {
+	*x = TestMask{}
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestMask) ProtoMessage() {}
+
+func (x *TestMask) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TestMask) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestMask) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestMask) GetData2() string {
+	if x != nil {
+		if x.xxx_hidden_Data2 != nil {
+			return *x.xxx_hidden_Data2
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestMask) GetData3() string {
+	if x != nil {
+		if x.xxx_hidden_Data3 != nil {
+			return *x.xxx_hidden_Data3
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestMask) GetData4() string {
+	if x != nil {
+		if x.xxx_hidden_Data4 != nil {
+			return *x.xxx_hidden_Data4
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestMask) GetData5() string {
+	if x != nil {
+		if x.xxx_hidden_Data5 != nil {
+			return *x.xxx_hidden_Data5
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestMask) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *TestMask) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+}
+
+func (x *TestMask) SetData2(v string) {
+	x.xxx_hidden_Data2 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+}
+
+func (x *TestMask) SetData3(v string) {
+	x.xxx_hidden_Data3 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
+
+func (x *TestMask) SetData4(v string) {
+	x.xxx_hidden_Data4 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *TestMask) SetData5(v string) {
+	x.xxx_hidden_Data5 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+}
+
+func (x *TestMask) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TestMask) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TestMask) HasData2() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *TestMask) HasData3() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *TestMask) HasData4() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *TestMask) HasData5() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *TestMask) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *TestMask) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+func (x *TestMask) ClearData2() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data2 = nil
+}
+
+func (x *TestMask) ClearData3() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Data3 = nil
+}
+
+func (x *TestMask) ClearData4() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Data4 = nil
+}
+
+func (x *TestMask) ClearData5() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Data5 = nil
+}
+
+type TestMask_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+	Data2 *string
+	Data3 *string
+	Data4 *string
+	Data5 *string
+}
+
+func (b0 TestMask_builder) Build() *TestMask {
+	m0 := &TestMask{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	if b.Data2 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
+		x.xxx_hidden_Data2 = b.Data2
+	}
+	if b.Data3 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_Data3 = b.Data3
+	}
+	if b.Data4 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_Data4 = b.Data4
+	}
+	if b.Data5 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		x.xxx_hidden_Data5 = b.Data5
+	}
+	return m0
+}
+
+type TestPseudonymize struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	xxx_hidden_Data2       *string                `protobuf:"bytes,3,opt,name=data2"`
+	xxx_hidden_Data3       *string                `protobuf:"bytes,4,opt,name=data3"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TestPseudonymize) Reset() {
+	*x = TestPseudonymize{}
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestPseudonymize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestPseudonymize) ProtoMessage() {}
+
+func (x *TestPseudonymize) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TestPseudonymize) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestPseudonymize) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestPseudonymize) GetData2() string {
+	if x != nil {
+		if x.xxx_hidden_Data2 != nil {
+			return *x.xxx_hidden_Data2
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestPseudonymize) GetData3() string {
+	if x != nil {
+		if x.xxx_hidden_Data3 != nil {
+			return *x.xxx_hidden_Data3
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestPseudonymize) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *TestPseudonymize) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *TestPseudonymize) SetData2(v string) {
+	x.xxx_hidden_Data2 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *TestPseudonymize) SetData3(v string) {
+	x.xxx_hidden_Data3 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *TestPseudonymize) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TestPseudonymize) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TestPseudonymize) HasData2() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *TestPseudonymize) HasData3() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *TestPseudonymize) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *TestPseudonymize) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+func (x *TestPseudonymize) ClearData2() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data2 = nil
+}
+
+func (x *TestPseudonymize) ClearData3() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Data3 = nil
+}
+
+type TestPseudonymize_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+	Data2 *string
+	Data3 *string
+}
+
+func (b0 TestPseudonymize_builder) Build() *TestPseudonymize {
+	m0 := &TestPseudonymize{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	if b.Data2 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Data2 = b.Data2
+	}
+	if b.Data3 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Data3 = b.Data3
+	}
+	return m0
+}
+
+type TestBlindIndex struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	xxx_hidden_Data1Index  *string                `protobuf:"bytes,3,opt,name=data1_index,json=data1Index"`
+	xxx_hidden_Data2       *string                `protobuf:"bytes,4,opt,name=data2"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TestBlindIndex) Reset() {
+	*x = TestBlindIndex{}
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestBlindIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestBlindIndex) ProtoMessage() {}
+
+func (x *TestBlindIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TestBlindIndex) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestBlindIndex) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestBlindIndex) GetData1Index() string {
+	if x != nil {
+		if x.xxx_hidden_Data1Index != nil {
+			return *x.xxx_hidden_Data1Index
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestBlindIndex) GetData2() string {
+	if x != nil {
+		if x.xxx_hidden_Data2 != nil {
+			return *x.xxx_hidden_Data2
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestBlindIndex) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *TestBlindIndex) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *TestBlindIndex) SetData1Index(v string) {
+	x.xxx_hidden_Data1Index = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *TestBlindIndex) SetData2(v string) {
+	x.xxx_hidden_Data2 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *TestBlindIndex) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TestBlindIndex) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TestBlindIndex) HasData1Index() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *TestBlindIndex) HasData2() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *TestBlindIndex) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *TestBlindIndex) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+func (x *TestBlindIndex) ClearData1Index() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data1Index = nil
+}
+
+func (x *TestBlindIndex) ClearData2() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Data2 = nil
+}
+
+type TestBlindIndex_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id         *string
+	Data1      *string
+	Data1Index *string
+	Data2      *string
+}
+
+func (b0 TestBlindIndex_builder) Build() *TestBlindIndex {
+	m0 := &TestBlindIndex{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	if b.Data1Index != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Data1Index = b.Data1Index
+	}
+	if b.Data2 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Data2 = b.Data2
+	}
+	return m0
+}
+
+type TestBlindIndexRepeated struct {
+	state                  protoimpl.MessageState         `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                        `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Items       *[]*TestBlindIndexRepeatedItem `protobuf:"bytes,2,rep,name=items"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TestBlindIndexRepeated) Reset() {
+	*x = TestBlindIndexRepeated{}
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestBlindIndexRepeated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestBlindIndexRepeated) ProtoMessage() {}
+
+func (x *TestBlindIndexRepeated) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TestBlindIndexRepeated) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestBlindIndexRepeated) GetItems() []*TestBlindIndexRepeatedItem {
+	if x != nil {
+		if x.xxx_hidden_Items != nil {
+			return *x.xxx_hidden_Items
+		}
+	}
+	return nil
+}
+
+func (x *TestBlindIndexRepeated) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *TestBlindIndexRepeated) SetItems(v []*TestBlindIndexRepeatedItem) {
+	x.xxx_hidden_Items = &v
+}
+
+func (x *TestBlindIndexRepeated) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TestBlindIndexRepeated) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type TestBlindIndexRepeated_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Items []*TestBlindIndexRepeatedItem
+}
+
+func (b0 TestBlindIndexRepeated_builder) Build() *TestBlindIndexRepeated {
+	m0 := &TestBlindIndexRepeated{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_Items = &b.Items
+	return m0
+}
+
+type TestBlindIndexRepeatedItem struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,1,opt,name=data1"`
+	xxx_hidden_Data1Index  *string                `protobuf:"bytes,2,opt,name=data1_index,json=data1Index"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TestBlindIndexRepeatedItem) Reset() {
+	*x = TestBlindIndexRepeatedItem{}
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestBlindIndexRepeatedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestBlindIndexRepeatedItem) ProtoMessage() {}
+
+func (x *TestBlindIndexRepeatedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TestBlindIndexRepeatedItem) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestBlindIndexRepeatedItem) GetData1Index() string {
+	if x != nil {
+		if x.xxx_hidden_Data1Index != nil {
+			return *x.xxx_hidden_Data1Index
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestBlindIndexRepeatedItem) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *TestBlindIndexRepeatedItem) SetData1Index(v string) {
+	x.xxx_hidden_Data1Index = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *TestBlindIndexRepeatedItem) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TestBlindIndexRepeatedItem) HasData1Index() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TestBlindIndexRepeatedItem) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Data1 = nil
+}
+
+func (x *TestBlindIndexRepeatedItem) ClearData1Index() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1Index = nil
+}
+
+type TestBlindIndexRepeatedItem_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Data1      *string
+	Data1Index *string
+}
+
+func (b0 TestBlindIndexRepeatedItem_builder) Build() *TestBlindIndexRepeatedItem {
+	m0 := &TestBlindIndexRepeatedItem{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	if b.Data1Index != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1Index = b.Data1Index
+	}
+	return m0
+}
+
+type TestCategories struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	xxx_hidden_Data2       *string                `protobuf:"bytes,3,opt,name=data2"`
+	xxx_hidden_Data3       *string                `protobuf:"bytes,4,opt,name=data3"`
+	xxx_hidden_Data4       *string                `protobuf:"bytes,5,opt,name=data4"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TestCategories) Reset() {
+	*x = TestCategories{}
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestCategories) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCategories) ProtoMessage() {}
+
+func (x *TestCategories) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TestCategories) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestCategories) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestCategories) GetData2() string {
+	if x != nil {
+		if x.xxx_hidden_Data2 != nil {
+			return *x.xxx_hidden_Data2
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestCategories) GetData3() string {
+	if x != nil {
+		if x.xxx_hidden_Data3 != nil {
+			return *x.xxx_hidden_Data3
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestCategories) GetData4() string {
+	if x != nil {
+		if x.xxx_hidden_Data4 != nil {
+			return *x.xxx_hidden_Data4
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestCategories) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *TestCategories) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *TestCategories) SetData2(v string) {
+	x.xxx_hidden_Data2 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *TestCategories) SetData3(v string) {
+	x.xxx_hidden_Data3 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *TestCategories) SetData4(v string) {
+	x.xxx_hidden_Data4 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *TestCategories) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TestCategories) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TestCategories) HasData2() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *TestCategories) HasData3() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *TestCategories) HasData4() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *TestCategories) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *TestCategories) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+func (x *TestCategories) ClearData2() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data2 = nil
+}
+
+func (x *TestCategories) ClearData3() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Data3 = nil
+}
+
+func (x *TestCategories) ClearData4() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Data4 = nil
+}
+
+type TestCategories_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+	Data2 *string
+	Data3 *string
+	Data4 *string
+}
+
+func (b0 TestCategories_builder) Build() *TestCategories {
+	m0 := &TestCategories{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	if b.Data2 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_Data2 = b.Data2
+	}
+	if b.Data3 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Data3 = b.Data3
+	}
+	if b.Data4 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_Data4 = b.Data4
+	}
+	return m0
+}
+
+type TestCondition struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Channel     *string                `protobuf:"bytes,2,opt,name=channel"`
+	xxx_hidden_Notes       *string                `protobuf:"bytes,3,opt,name=notes"`
+	xxx_hidden_Address     *TestCondition_Address `protobuf:"bytes,4,opt,name=address"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TestCondition) Reset() {
+	*x = TestCondition{}
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCondition) ProtoMessage() {}
+
+func (x *TestCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TestCondition) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestCondition) GetChannel() string {
+	if x != nil {
+		if x.xxx_hidden_Channel != nil {
+			return *x.xxx_hidden_Channel
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestCondition) GetNotes() string {
+	if x != nil {
+		if x.xxx_hidden_Notes != nil {
+			return *x.xxx_hidden_Notes
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestCondition) GetAddress() *TestCondition_Address {
+	if x != nil {
+		return x.xxx_hidden_Address
+	}
+	return nil
+}
+
+func (x *TestCondition) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *TestCondition) SetChannel(v string) {
+	x.xxx_hidden_Channel = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *TestCondition) SetNotes(v string) {
+	x.xxx_hidden_Notes = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *TestCondition) SetAddress(v *TestCondition_Address) {
+	x.xxx_hidden_Address = v
+}
+
+func (x *TestCondition) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TestCondition) HasChannel() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TestCondition) HasNotes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *TestCondition) HasAddress() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Address != nil
+}
+
+func (x *TestCondition) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *TestCondition) ClearChannel() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Channel = nil
+}
+
+func (x *TestCondition) ClearNotes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Notes = nil
+}
+
+func (x *TestCondition) ClearAddress() {
+	x.xxx_hidden_Address = nil
+}
+
+type TestCondition_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id      *string
+	Channel *string
+	Notes   *string
+	Address *TestCondition_Address
+}
+
+func (b0 TestCondition_builder) Build() *TestCondition {
+	m0 := &TestCondition{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Channel != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Channel = b.Channel
+	}
+	if b.Notes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Notes = b.Notes
+	}
+	x.xxx_hidden_Address = b.Address
+	return m0
+}
+
+type TestRetention struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,3,opt,name=data1"`
+	xxx_hidden_Data2       *string                `protobuf:"bytes,4,opt,name=data2"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TestRetention) Reset() {
+	*x = TestRetention{}
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestRetention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRetention) ProtoMessage() {}
+
+func (x *TestRetention) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TestRetention) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestRetention) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *TestRetention) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestRetention) GetData2() string {
+	if x != nil {
+		if x.xxx_hidden_Data2 != nil {
+			return *x.xxx_hidden_Data2
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestRetention) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *TestRetention) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *TestRetention) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *TestRetention) SetData2(v string) {
+	x.xxx_hidden_Data2 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *TestRetention) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TestRetention) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *TestRetention) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *TestRetention) HasData2() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *TestRetention) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *TestRetention) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *TestRetention) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data1 = nil
+}
+
+func (x *TestRetention) ClearData2() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Data2 = nil
+}
+
+type TestRetention_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        *string
+	CreatedAt *timestamppb.Timestamp
+	Data1     *string
+	Data2     *string
+}
+
+func (b0 TestRetention_builder) Build() *TestRetention {
+	m0 := &TestRetention{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	if b.Data2 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Data2 = b.Data2
+	}
+	return m0
+}
+
+type TestRetentionWithoutRetentionOnAllFields struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,3,opt,name=data1"`
+	xxx_hidden_Data2       *string                `protobuf:"bytes,4,opt,name=data2"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) Reset() {
+	*x = TestRetentionWithoutRetentionOnAllFields{}
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRetentionWithoutRetentionOnAllFields) ProtoMessage() {}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) GetData2() string {
+	if x != nil {
+		if x.xxx_hidden_Data2 != nil {
+			return *x.xxx_hidden_Data2
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) SetData2(v string) {
+	x.xxx_hidden_Data2 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) HasData2() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data1 = nil
+}
+
+func (x *TestRetentionWithoutRetentionOnAllFields) ClearData2() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Data2 = nil
+}
+
+type TestRetentionWithoutRetentionOnAllFields_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        *string
+	CreatedAt *timestamppb.Timestamp
+	Data1     *string
+	Data2     *string
+}
+
+func (b0 TestRetentionWithoutRetentionOnAllFields_builder) Build() *TestRetentionWithoutRetentionOnAllFields {
+	m0 := &TestRetentionWithoutRetentionOnAllFields{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	if b.Data2 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Data2 = b.Data2
+	}
+	return m0
+}
+
+type TestKeyBucket struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,3,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TestKeyBucket) Reset() {
+	*x = TestKeyBucket{}
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestKeyBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestKeyBucket) ProtoMessage() {}
+
+func (x *TestKeyBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TestKeyBucket) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestKeyBucket) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *TestKeyBucket) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestKeyBucket) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *TestKeyBucket) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *TestKeyBucket) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *TestKeyBucket) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TestKeyBucket) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *TestKeyBucket) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *TestKeyBucket) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *TestKeyBucket) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *TestKeyBucket) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data1 = nil
+}
+
+type TestKeyBucket_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        *string
+	CreatedAt *timestamppb.Timestamp
+	Data1     *string
+}
+
+func (b0 TestKeyBucket_builder) Build() *TestKeyBucket {
+	m0 := &TestKeyBucket{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type TestKeyBucketFromClock struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TestKeyBucketFromClock) Reset() {
+	*x = TestKeyBucketFromClock{}
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestKeyBucketFromClock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestKeyBucketFromClock) ProtoMessage() {}
+
+func (x *TestKeyBucketFromClock) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TestKeyBucketFromClock) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestKeyBucketFromClock) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestKeyBucketFromClock) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *TestKeyBucketFromClock) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *TestKeyBucketFromClock) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TestKeyBucketFromClock) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TestKeyBucketFromClock) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *TestKeyBucketFromClock) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+type TestKeyBucketFromClock_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+}
+
+func (b0 TestKeyBucketFromClock_builder) Build() *TestKeyBucketFromClock {
+	m0 := &TestKeyBucketFromClock{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type TestCondition_Address struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Country     *string                `protobuf:"bytes,1,opt,name=country"`
+	xxx_hidden_Line1       *string                `protobuf:"bytes,2,opt,name=line1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TestCondition_Address) Reset() {
+	*x = TestCondition_Address{}
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestCondition_Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCondition_Address) ProtoMessage() {}
+
+func (x *TestCondition_Address) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_test_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TestCondition_Address) GetCountry() string {
+	if x != nil {
+		if x.xxx_hidden_Country != nil {
+			return *x.xxx_hidden_Country
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestCondition_Address) GetLine1() string {
+	if x != nil {
+		if x.xxx_hidden_Line1 != nil {
+			return *x.xxx_hidden_Line1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TestCondition_Address) SetCountry(v string) {
+	x.xxx_hidden_Country = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *TestCondition_Address) SetLine1(v string) {
+	x.xxx_hidden_Line1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *TestCondition_Address) HasCountry() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TestCondition_Address) HasLine1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TestCondition_Address) ClearCountry() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Country = nil
+}
+
+func (x *TestCondition_Address) ClearLine1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Line1 = nil
+}
+
+type TestCondition_Address_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Country *string
+	Line1   *string
+}
+
+func (b0 TestCondition_Address_builder) Build() *TestCondition_Address {
+	m0 := &TestCondition_Address{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Country != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Country = b.Country
+	}
+	if b.Line1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Line1 = b.Line1
+	}
+	return m0
+}
+
 var File_boostport_privacy_testing_test_proto protoreflect.FileDescriptor
 
 const file_boostport_privacy_testing_test_proto_rawDesc = "" +
 	"\n" +
-	"$boostport/privacy/testing/test.proto\x12\x19boostport.privacy.testing\x1a\x1fboostport/privacy/privacy.proto\"z\n" +
+	"$boostport/privacy/testing/test.proto\x12\x19boostport.privacy.testing\x1a\x1fboostport/privacy/privacy.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"z\n" +
 	"\vTestNested1\x12\x1b\n" +
 	"\x05data1\x18\x01 \x01(\tB\x05\x82}\x02\x12\x00R\x05data1\x12\x1b\n" +
 	"\x05data2\x18\x02 \x01(\tB\x05\x82}\x02\x12\x00R\x05data2\x12\x1b\n" +
@@ -1219,34 +3097,136 @@ const file_boostport_privacy_testing_test_proto_rawDesc = "" +
 	"\x06data12\x18\r \x01(\x10B\x0e\x82}\v\x12\ta\x01\x00\x00\x00\x00\x00\x00\x00R\x06data12\x12\x1f\n" +
 	"\x06data13\x18\x0e \x01(\bB\a\x82}\x04\x12\x02h\x01R\x06data13\x12#\n" +
 	"\x06data14\x18\x0f \x01(\tB\v\x82}\b\x12\x06r\x04testR\x06data14\x12#\n" +
-	"\x06data15\x18\x10 \x01(\fB\v\x82}\b\x12\x06z\x04testR\x06data15B\x80\x02\n" +
+	"\x06data15\x18\x10 \x01(\fB\v\x82}\b\x12\x06z\x04testR\x06data15\"\xce\x01\n" +
+	"\bTestMask\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12 \n" +
+	"\x05data1\x18\x02 \x01(\tB\n" +
+	"\x82}\a\x12\x05\x82\x01\x02\b\x04R\x05data1\x12 \n" +
+	"\x05data2\x18\x03 \x01(\tB\n" +
+	"\x82}\a\x12\x05\x82\x01\x02\x10\x02R\x05data2\x12 \n" +
+	"\x05data3\x18\x04 \x01(\tB\n" +
+	"\x82}\a\x12\x05\x82\x01\x02\x18\x01R\x05data3\x12#\n" +
+	"\x05data4\x18\x05 \x01(\tB\r\x82}\n" +
+	"\x12\b\x82\x01\x052\x01# \x03R\x05data4\x12 \n" +
+	"\x05data5\x18\x06 \x01(\tB\n" +
+	"\x82}\a\x12\x05\x82\x01\x02(\x01R\x05data5\"\x8d\x01\n" +
+	"\x10TestPseudonymize\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12 \n" +
+	"\x05data1\x18\x02 \x01(\tB\n" +
+	"\x82}\a\x12\x05\x8a\x01\x02\b\x01R\x05data1\x12 \n" +
+	"\x05data2\x18\x03 \x01(\tB\n" +
+	"\x82}\a\x12\x05\x8a\x01\x02\b\x02R\x05data2\x12\x1e\n" +
+	"\x05data3\x18\x04 \x01(\tB\b\x82}\x05\x12\x03\x8a\x01\x00R\x05data3\"\x99\x01\n" +
+	"\x0eTestBlindIndex\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12+\n" +
+	"\x05data1\x18\x02 \x01(\tB\x15\x82}\x12\x12\x10\x92\x01\r\n" +
+	"\vdata1_indexR\x05data1\x12\x1f\n" +
+	"\vdata1_index\x18\x03 \x01(\tR\n" +
+	"data1Index\x12\"\n" +
+	"\x05data2\x18\x04 \x01(\tB\f\x82}\t\x12\a\x92\x01\x04\x10\x04\x18\x03R\x05data2\"|\n" +
+	"\x16TestBlindIndexRepeated\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12K\n" +
+	"\x05items\x18\x02 \x03(\v25.boostport.privacy.testing.TestBlindIndexRepeatedItemR\x05items\"j\n" +
+	"\x1aTestBlindIndexRepeatedItem\x12+\n" +
+	"\x05data1\x18\x01 \x01(\tB\x15\x82}\x12\x12\x10\x92\x01\r\n" +
+	"\vdata1_indexR\x05data1\x12\x1f\n" +
+	"\vdata1_index\x18\x02 \x01(\tR\n" +
+	"data1Index\"\xb5\x01\n" +
+	"\x0eTestCategories\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12\x1e\n" +
+	"\x05data1\x18\x02 \x01(\tB\b\x82}\x05\x12\x03\x98\x01\x01R\x05data1\x12&\n" +
+	"\x05data2\x18\x03 \x01(\tB\x10\x82}\r\x12\v\x98\x01\x06r\x06hiddenR\x05data2\x12'\n" +
+	"\x05data3\x18\x04 \x01(\tB\x11\x82}\x0e\x12\f\xa2\x01\tmarketingR\x05data3\x12\x1b\n" +
+	"\x05data4\x18\x05 \x01(\tB\x05\x82}\x02\x12\x00R\x05data4\"\xa5\x02\n" +
+	"\rTestCondition\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12;\n" +
+	"\x05notes\x18\x03 \x01(\tB%\x82}\"\x12 \xaa\x01\x15channel == \"customer\"r\x06hiddenR\x05notes\x12J\n" +
+	"\aaddress\x18\x04 \x01(\v20.boostport.privacy.testing.TestCondition.AddressR\aaddress\x1aZ\n" +
+	"\aAddress\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x125\n" +
+	"\x05line1\x18\x02 \x01(\tB\x1f\x82}\x1c\x12\x1a\xaa\x01\x17country in [\"DE\", \"FR\"]R\x05line1\"\xc3\x01\n" +
+	"\rTestRetention\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12+\n" +
+	"\x05data1\x18\x03 \x01(\tB\x15\x82}\x12\x12\x10\xb2\x01\x04\b\x80\xa3\x05r\aexpiredR\x05data1\x12\"\n" +
+	"\x05data2\x18\x04 \x01(\tB\f\x82}\t\x12\a\xb2\x01\x04\b\x80\xde4R\x05data2:\x0f\x82}\f\n" +
+	"\n" +
+	"created_at\"\xce\x01\n" +
+	"(TestRetentionWithoutRetentionOnAllFields\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\"\n" +
+	"\x05data1\x18\x03 \x01(\tB\f\x82}\t\x12\a\xb2\x01\x04\b\x80\xa3\x05R\x05data1\x12\x1b\n" +
+	"\x05data2\x18\x04 \x01(\tB\x05\x82}\x02\x12\x00R\x05data2:\x0f\x82}\f\n" +
+	"\n" +
+	"created_at\"\x93\x01\n" +
+	"\rTestKeyBucket\x12*\n" +
+	"\x02id\x18\x01 \x01(\tB\x1a\x82}\x17\n" +
+	"\x15\n" +
+	"\x05user:\x10\x01\x1a\n" +
+	"created_atR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\x05data1\x18\x03 \x01(\tB\x05\x82}\x02\x12\x00R\x05data1\"N\n" +
+	"\x16TestKeyBucketFromClock\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\x82}\x04\n" +
+	"\x02\x10\x02R\x02id\x12\x1b\n" +
+	"\x05data1\x18\x02 \x01(\tB\x05\x82}\x02\x12\x00R\x05data1B\x80\x02\n" +
 	"\x1dcom.boostport.privacy.testingB\tTestProtoP\x01ZNgithub.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing\xa2\x02\x03BPT\xaa\x02\x19Boostport.Privacy.Testing\xca\x02\x19Boostport\\Privacy\\Testing\xe2\x02%Boostport\\Privacy\\Testing\\GPBMetadata\xea\x02\x1bBoostport::Privacy::Testingb\beditionsp\xe8\a"
 
-var file_boostport_privacy_testing_test_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_boostport_privacy_testing_test_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_boostport_privacy_testing_test_proto_goTypes = []any{
-	(*TestNested1)(nil),       // 0: boostport.privacy.testing.TestNested1
-	(*TestNested2)(nil),       // 1: boostport.privacy.testing.TestNested2
-	(*TestMessage)(nil),       // 2: boostport.privacy.testing.TestMessage
-	(*TestFallbackTypes)(nil), // 3: boostport.privacy.testing.TestFallbackTypes
-	nil,                       // 4: boostport.privacy.testing.TestMessage.Data7Entry
-	nil,                       // 5: boostport.privacy.testing.TestMessage.Data8Entry
-	nil,                       // 6: boostport.privacy.testing.TestMessage.Data9Entry
+	(*TestNested1)(nil),                              // 0: boostport.privacy.testing.TestNested1
+	(*TestNested2)(nil),                              // 1: boostport.privacy.testing.TestNested2
+	(*TestMessage)(nil),                              // 2: boostport.privacy.testing.TestMessage
+	(*TestFallbackTypes)(nil),                        // 3: boostport.privacy.testing.TestFallbackTypes
+	(*TestMask)(nil),                                 // 4: boostport.privacy.testing.TestMask
+	(*TestPseudonymize)(nil),                         // 5: boostport.privacy.testing.TestPseudonymize
+	(*TestBlindIndex)(nil),                           // 6: boostport.privacy.testing.TestBlindIndex
+	(*TestBlindIndexRepeated)(nil),                   // 7: boostport.privacy.testing.TestBlindIndexRepeated
+	(*TestBlindIndexRepeatedItem)(nil),               // 8: boostport.privacy.testing.TestBlindIndexRepeatedItem
+	(*TestCategories)(nil),                           // 9: boostport.privacy.testing.TestCategories
+	(*TestCondition)(nil),                            // 10: boostport.privacy.testing.TestCondition
+	(*TestRetention)(nil),                            // 11: boostport.privacy.testing.TestRetention
+	(*TestRetentionWithoutRetentionOnAllFields)(nil), // 12: boostport.privacy.testing.TestRetentionWithoutRetentionOnAllFields
+	(*TestKeyBucket)(nil),                            // 13: boostport.privacy.testing.TestKeyBucket
+	(*TestKeyBucketFromClock)(nil),                   // 14: boostport.privacy.testing.TestKeyBucketFromClock
+	nil,                                              // 15: boostport.privacy.testing.TestMessage.Data7Entry
+	nil,                                              // 16: boostport.privacy.testing.TestMessage.Data8Entry
+	nil,                                              // 17: boostport.privacy.testing.TestMessage.Data9Entry
+	(*TestCondition_Address)(nil),                    // 18: boostport.privacy.testing.TestCondition.Address
+	(*timestamppb.Timestamp)(nil),                    // 19: google.protobuf.Timestamp
 }
 var file_boostport_privacy_testing_test_proto_depIdxs = []int32{
-	0, // 0: boostport.privacy.testing.TestMessage.data2:type_name -> boostport.privacy.testing.TestNested1
-	1, // 1: boostport.privacy.testing.TestMessage.data3:type_name -> boostport.privacy.testing.TestNested2
-	0, // 2: boostport.privacy.testing.TestMessage.data5:type_name -> boostport.privacy.testing.TestNested1
-	1, // 3: boostport.privacy.testing.TestMessage.data6:type_name -> boostport.privacy.testing.TestNested2
-	4, // 4: boostport.privacy.testing.TestMessage.data7:type_name -> boostport.privacy.testing.TestMessage.Data7Entry
-	5, // 5: boostport.privacy.testing.TestMessage.data8:type_name -> boostport.privacy.testing.TestMessage.Data8Entry
-	6, // 6: boostport.privacy.testing.TestMessage.data9:type_name -> boostport.privacy.testing.TestMessage.Data9Entry
-	0, // 7: boostport.privacy.testing.TestMessage.Data8Entry.value:type_name -> boostport.privacy.testing.TestNested1
-	1, // 8: boostport.privacy.testing.TestMessage.Data9Entry.value:type_name -> boostport.privacy.testing.TestNested2
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	0,  // 0: boostport.privacy.testing.TestMessage.data2:type_name -> boostport.privacy.testing.TestNested1
+	1,  // 1: boostport.privacy.testing.TestMessage.data3:type_name -> boostport.privacy.testing.TestNested2
+	0,  // 2: boostport.privacy.testing.TestMessage.data5:type_name -> boostport.privacy.testing.TestNested1
+	1,  // 3: boostport.privacy.testing.TestMessage.data6:type_name -> boostport.privacy.testing.TestNested2
+	15, // 4: boostport.privacy.testing.TestMessage.data7:type_name -> boostport.privacy.testing.TestMessage.Data7Entry
+	16, // 5: boostport.privacy.testing.TestMessage.data8:type_name -> boostport.privacy.testing.TestMessage.Data8Entry
+	17, // 6: boostport.privacy.testing.TestMessage.data9:type_name -> boostport.privacy.testing.TestMessage.Data9Entry
+	8,  // 7: boostport.privacy.testing.TestBlindIndexRepeated.items:type_name -> boostport.privacy.testing.TestBlindIndexRepeatedItem
+	18, // 8: boostport.privacy.testing.TestCondition.address:type_name -> boostport.privacy.testing.TestCondition.Address
+	19, // 9: boostport.privacy.testing.TestRetention.created_at:type_name -> google.protobuf.Timestamp
+	19, // 10: boostport.privacy.testing.TestRetentionWithoutRetentionOnAllFields.created_at:type_name -> google.protobuf.Timestamp
+	19, // 11: boostport.privacy.testing.TestKeyBucket.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 12: boostport.privacy.testing.TestMessage.Data8Entry.value:type_name -> boostport.privacy.testing.TestNested1
+	1,  // 13: boostport.privacy.testing.TestMessage.Data9Entry.value:type_name -> boostport.privacy.testing.TestNested2
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_boostport_privacy_testing_test_proto_init() }
@@ -1260,7 +3240,7 @@ func file_boostport_privacy_testing_test_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_boostport_privacy_testing_test_proto_rawDesc), len(file_boostport_privacy_testing_test_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
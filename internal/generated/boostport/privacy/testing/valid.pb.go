@@ -10,6 +10,7 @@ import (
 	_ "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	unsafe "unsafe"
 )
@@ -2518,6 +2519,788 @@ func (b0 ValidFallbackTypes_builder) Build() *ValidFallbackTypes {
 	return m0
 }
 
+type ValidMask struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ValidMask) Reset() {
+	*x = ValidMask{}
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidMask) ProtoMessage() {}
+
+func (x *ValidMask) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ValidMask) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ValidMask) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ValidMask) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ValidMask) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ValidMask) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ValidMask) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ValidMask) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *ValidMask) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+type ValidMask_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+}
+
+func (b0 ValidMask_builder) Build() *ValidMask {
+	m0 := &ValidMask{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type ValidPseudonymize struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ValidPseudonymize) Reset() {
+	*x = ValidPseudonymize{}
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidPseudonymize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidPseudonymize) ProtoMessage() {}
+
+func (x *ValidPseudonymize) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ValidPseudonymize) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ValidPseudonymize) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ValidPseudonymize) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ValidPseudonymize) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ValidPseudonymize) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ValidPseudonymize) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ValidPseudonymize) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *ValidPseudonymize) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+type ValidPseudonymize_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Data1 *string
+}
+
+func (b0 ValidPseudonymize_builder) Build() *ValidPseudonymize {
+	m0 := &ValidPseudonymize{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type ValidBlindIndex struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,2,opt,name=data1"`
+	xxx_hidden_Data1Index  *string                `protobuf:"bytes,3,opt,name=data1_index,json=data1Index"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ValidBlindIndex) Reset() {
+	*x = ValidBlindIndex{}
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidBlindIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidBlindIndex) ProtoMessage() {}
+
+func (x *ValidBlindIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ValidBlindIndex) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ValidBlindIndex) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ValidBlindIndex) GetData1Index() string {
+	if x != nil {
+		if x.xxx_hidden_Data1Index != nil {
+			return *x.xxx_hidden_Data1Index
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ValidBlindIndex) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *ValidBlindIndex) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *ValidBlindIndex) SetData1Index(v string) {
+	x.xxx_hidden_Data1Index = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *ValidBlindIndex) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ValidBlindIndex) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ValidBlindIndex) HasData1Index() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ValidBlindIndex) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *ValidBlindIndex) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data1 = nil
+}
+
+func (x *ValidBlindIndex) ClearData1Index() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data1Index = nil
+}
+
+type ValidBlindIndex_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id         *string
+	Data1      *string
+	Data1Index *string
+}
+
+func (b0 ValidBlindIndex_builder) Build() *ValidBlindIndex {
+	m0 := &ValidBlindIndex{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	if b.Data1Index != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Data1Index = b.Data1Index
+	}
+	return m0
+}
+
+type ValidCondition struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Channel     *string                `protobuf:"bytes,2,opt,name=channel"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,3,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ValidCondition) Reset() {
+	*x = ValidCondition{}
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidCondition) ProtoMessage() {}
+
+func (x *ValidCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ValidCondition) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ValidCondition) GetChannel() string {
+	if x != nil {
+		if x.xxx_hidden_Channel != nil {
+			return *x.xxx_hidden_Channel
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ValidCondition) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ValidCondition) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *ValidCondition) SetChannel(v string) {
+	x.xxx_hidden_Channel = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *ValidCondition) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *ValidCondition) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ValidCondition) HasChannel() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ValidCondition) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ValidCondition) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *ValidCondition) ClearChannel() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Channel = nil
+}
+
+func (x *ValidCondition) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data1 = nil
+}
+
+type ValidCondition_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id      *string
+	Channel *string
+	Data1   *string
+}
+
+func (b0 ValidCondition_builder) Build() *ValidCondition {
+	m0 := &ValidCondition{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Channel != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Channel = b.Channel
+	}
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type ValidRetention struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,3,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ValidRetention) Reset() {
+	*x = ValidRetention{}
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidRetention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidRetention) ProtoMessage() {}
+
+func (x *ValidRetention) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ValidRetention) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ValidRetention) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *ValidRetention) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ValidRetention) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *ValidRetention) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *ValidRetention) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *ValidRetention) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ValidRetention) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *ValidRetention) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ValidRetention) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *ValidRetention) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *ValidRetention) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data1 = nil
+}
+
+type ValidRetention_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        *string
+	CreatedAt *timestamppb.Timestamp
+	Data1     *string
+}
+
+func (b0 ValidRetention_builder) Build() *ValidRetention {
+	m0 := &ValidRetention{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
+type ValidKeyBucket struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Data1       *string                `protobuf:"bytes,3,opt,name=data1"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ValidKeyBucket) Reset() {
+	*x = ValidKeyBucket{}
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidKeyBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidKeyBucket) ProtoMessage() {}
+
+func (x *ValidKeyBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ValidKeyBucket) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ValidKeyBucket) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *ValidKeyBucket) GetData1() string {
+	if x != nil {
+		if x.xxx_hidden_Data1 != nil {
+			return *x.xxx_hidden_Data1
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ValidKeyBucket) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *ValidKeyBucket) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *ValidKeyBucket) SetData1(v string) {
+	x.xxx_hidden_Data1 = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *ValidKeyBucket) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ValidKeyBucket) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *ValidKeyBucket) HasData1() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ValidKeyBucket) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *ValidKeyBucket) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *ValidKeyBucket) ClearData1() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data1 = nil
+}
+
+type ValidKeyBucket_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        *string
+	CreatedAt *timestamppb.Timestamp
+	Data1     *string
+}
+
+func (b0 ValidKeyBucket_builder) Build() *ValidKeyBucket {
+	m0 := &ValidKeyBucket{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	if b.Data1 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Data1 = b.Data1
+	}
+	return m0
+}
+
 type ValidDataSubjectIDInNestedMessage_Nested struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
@@ -2530,7 +3313,7 @@ type ValidDataSubjectIDInNestedMessage_Nested struct {
 
 func (x *ValidDataSubjectIDInNestedMessage_Nested) Reset() {
 	*x = ValidDataSubjectIDInNestedMessage_Nested{}
-	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[19]
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2542,7 +3325,7 @@ func (x *ValidDataSubjectIDInNestedMessage_Nested) String() string {
 func (*ValidDataSubjectIDInNestedMessage_Nested) ProtoMessage() {}
 
 func (x *ValidDataSubjectIDInNestedMessage_Nested) ProtoReflect() protoreflect.Message {
-	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[19]
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2641,7 +3424,7 @@ type ValidPersonalDataIsMessage_Nested struct {
 
 func (x *ValidPersonalDataIsMessage_Nested) Reset() {
 	*x = ValidPersonalDataIsMessage_Nested{}
-	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[20]
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2653,7 +3436,7 @@ func (x *ValidPersonalDataIsMessage_Nested) String() string {
 func (*ValidPersonalDataIsMessage_Nested) ProtoMessage() {}
 
 func (x *ValidPersonalDataIsMessage_Nested) ProtoReflect() protoreflect.Message {
-	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[20]
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2752,7 +3535,7 @@ type ValidPersonalDataInNestedMessage_Nested struct {
 
 func (x *ValidPersonalDataInNestedMessage_Nested) Reset() {
 	*x = ValidPersonalDataInNestedMessage_Nested{}
-	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[21]
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2764,7 +3547,7 @@ func (x *ValidPersonalDataInNestedMessage_Nested) String() string {
 func (*ValidPersonalDataInNestedMessage_Nested) ProtoMessage() {}
 
 func (x *ValidPersonalDataInNestedMessage_Nested) ProtoReflect() protoreflect.Message {
-	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[21]
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2863,7 +3646,7 @@ type ValidMultiplePersonalData_Nested struct {
 
 func (x *ValidMultiplePersonalData_Nested) Reset() {
 	*x = ValidMultiplePersonalData_Nested{}
-	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[22]
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2875,7 +3658,7 @@ func (x *ValidMultiplePersonalData_Nested) String() string {
 func (*ValidMultiplePersonalData_Nested) ProtoMessage() {}
 
 func (x *ValidMultiplePersonalData_Nested) ProtoReflect() protoreflect.Message {
-	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[22]
+	mi := &file_boostport_privacy_testing_valid_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2966,7 +3749,7 @@ var File_boostport_privacy_testing_valid_proto protoreflect.FileDescriptor
 
 const file_boostport_privacy_testing_valid_proto_rawDesc = "" +
 	"\n" +
-	"%boostport/privacy/testing/valid.proto\x12\x19boostport.privacy.testing\x1a\x1fboostport/privacy/privacy.proto\"H\n" +
+	"%boostport/privacy/testing/valid.proto\x12\x19boostport.privacy.testing\x1a\x1fboostport/privacy/privacy.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"H\n" +
 	"\x12ValidDataSubjectID\x12\x15\n" +
 	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
 	"\x00R\x02id\x12\x1b\n" +
@@ -3074,11 +3857,47 @@ const file_boostport_privacy_testing_valid_proto_rawDesc = "" +
 	"\x06data12\x18\r \x01(\x06B\x0e\x82}\v\x12\tQ\x01\x00\x00\x00\x00\x00\x00\x00R\x06data12\x12&\n" +
 	"\x06data13\x18\x0e \x01(\x01B\x0e\x82}\v\x12\t\t\x00\x00\x00\x00\x00\x00\xf0?R\x06data13\x12#\n" +
 	"\x06data14\x18\x0f \x01(\tB\v\x82}\b\x12\x06r\x04testR\x06data14\x12#\n" +
-	"\x06data15\x18\x10 \x01(\fB\v\x82}\b\x12\x06z\x04testR\x06data15B\x81\x02\n" +
+	"\x06data15\x18\x10 \x01(\fB\v\x82}\b\x12\x06z\x04testR\x06data15\"D\n" +
+	"\tValidMask\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12 \n" +
+	"\x05data1\x18\x02 \x01(\tB\n" +
+	"\x82}\a\x12\x05\x82\x01\x02\b\x04R\x05data1\"J\n" +
+	"\x11ValidPseudonymize\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12\x1e\n" +
+	"\x05data1\x18\x02 \x01(\tB\b\x82}\x05\x12\x03\x8a\x01\x00R\x05data1\"v\n" +
+	"\x0fValidBlindIndex\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12+\n" +
+	"\x05data1\x18\x02 \x01(\tB\x15\x82}\x12\x12\x10\x92\x01\r\n" +
+	"\vdata1_indexR\x05data1\x12\x1f\n" +
+	"\vdata1_index\x18\x03 \x01(\tR\n" +
+	"data1Index\"v\n" +
+	"\x0eValidCondition\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x123\n" +
+	"\x05data1\x18\x03 \x01(\tB\x1d\x82}\x1a\x12\x18\xaa\x01\x15channel == \"customer\"R\x05data1\"\x97\x01\n" +
+	"\x0eValidRetention\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\x82}\x02\n" +
+	"\x00R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\"\n" +
+	"\x05data1\x18\x03 \x01(\tB\f\x82}\t\x12\a\xb2\x01\x04\b\x80\xa3\x05R\x05data1:\x0f\x82}\f\n" +
+	"\n" +
+	"created_at\"\x8d\x01\n" +
+	"\x0eValidKeyBucket\x12#\n" +
+	"\x02id\x18\x01 \x01(\tB\x13\x82}\x10\n" +
+	"\x0e\x10\x02\x1a\n" +
+	"created_atR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\x05data1\x18\x03 \x01(\tB\x05\x82}\x02\x12\x00R\x05data1B\x81\x02\n" +
 	"\x1dcom.boostport.privacy.testingB\n" +
 	"ValidProtoP\x01ZNgithub.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing\xa2\x02\x03BPT\xaa\x02\x19Boostport.Privacy.Testing\xca\x02\x19Boostport\\Privacy\\Testing\xe2\x02%Boostport\\Privacy\\Testing\\GPBMetadata\xea\x02\x1bBoostport::Privacy::Testingb\beditionsp\xe8\a"
 
-var file_boostport_privacy_testing_valid_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_boostport_privacy_testing_valid_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_boostport_privacy_testing_valid_proto_goTypes = []any{
 	(*ValidDataSubjectID)(nil),                       // 0: boostport.privacy.testing.ValidDataSubjectID
 	(*ValidDataSubjectIDWithPrefix)(nil),             // 1: boostport.privacy.testing.ValidDataSubjectIDWithPrefix
@@ -3099,21 +3918,30 @@ var file_boostport_privacy_testing_valid_proto_goTypes = []any{
 	(*ValidPersonalDataInNestedMessage)(nil),         // 16: boostport.privacy.testing.ValidPersonalDataInNestedMessage
 	(*ValidMultiplePersonalData)(nil),                // 17: boostport.privacy.testing.ValidMultiplePersonalData
 	(*ValidFallbackTypes)(nil),                       // 18: boostport.privacy.testing.ValidFallbackTypes
-	(*ValidDataSubjectIDInNestedMessage_Nested)(nil), // 19: boostport.privacy.testing.ValidDataSubjectIDInNestedMessage.Nested
-	(*ValidPersonalDataIsMessage_Nested)(nil),        // 20: boostport.privacy.testing.ValidPersonalDataIsMessage.Nested
-	(*ValidPersonalDataInNestedMessage_Nested)(nil),  // 21: boostport.privacy.testing.ValidPersonalDataInNestedMessage.Nested
-	(*ValidMultiplePersonalData_Nested)(nil),         // 22: boostport.privacy.testing.ValidMultiplePersonalData.Nested
+	(*ValidMask)(nil),                                // 19: boostport.privacy.testing.ValidMask
+	(*ValidPseudonymize)(nil),                        // 20: boostport.privacy.testing.ValidPseudonymize
+	(*ValidBlindIndex)(nil),                          // 21: boostport.privacy.testing.ValidBlindIndex
+	(*ValidCondition)(nil),                           // 22: boostport.privacy.testing.ValidCondition
+	(*ValidRetention)(nil),                           // 23: boostport.privacy.testing.ValidRetention
+	(*ValidKeyBucket)(nil),                           // 24: boostport.privacy.testing.ValidKeyBucket
+	(*ValidDataSubjectIDInNestedMessage_Nested)(nil), // 25: boostport.privacy.testing.ValidDataSubjectIDInNestedMessage.Nested
+	(*ValidPersonalDataIsMessage_Nested)(nil),        // 26: boostport.privacy.testing.ValidPersonalDataIsMessage.Nested
+	(*ValidPersonalDataInNestedMessage_Nested)(nil),  // 27: boostport.privacy.testing.ValidPersonalDataInNestedMessage.Nested
+	(*ValidMultiplePersonalData_Nested)(nil),         // 28: boostport.privacy.testing.ValidMultiplePersonalData.Nested
+	(*timestamppb.Timestamp)(nil),                    // 29: google.protobuf.Timestamp
 }
 var file_boostport_privacy_testing_valid_proto_depIdxs = []int32{
-	19, // 0: boostport.privacy.testing.ValidDataSubjectIDInNestedMessage.data:type_name -> boostport.privacy.testing.ValidDataSubjectIDInNestedMessage.Nested
-	20, // 1: boostport.privacy.testing.ValidPersonalDataIsMessage.data:type_name -> boostport.privacy.testing.ValidPersonalDataIsMessage.Nested
-	21, // 2: boostport.privacy.testing.ValidPersonalDataInNestedMessage.data:type_name -> boostport.privacy.testing.ValidPersonalDataInNestedMessage.Nested
-	22, // 3: boostport.privacy.testing.ValidMultiplePersonalData.data:type_name -> boostport.privacy.testing.ValidMultiplePersonalData.Nested
-	4,  // [4:4] is the sub-list for method output_type
-	4,  // [4:4] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	25, // 0: boostport.privacy.testing.ValidDataSubjectIDInNestedMessage.data:type_name -> boostport.privacy.testing.ValidDataSubjectIDInNestedMessage.Nested
+	26, // 1: boostport.privacy.testing.ValidPersonalDataIsMessage.data:type_name -> boostport.privacy.testing.ValidPersonalDataIsMessage.Nested
+	27, // 2: boostport.privacy.testing.ValidPersonalDataInNestedMessage.data:type_name -> boostport.privacy.testing.ValidPersonalDataInNestedMessage.Nested
+	28, // 3: boostport.privacy.testing.ValidMultiplePersonalData.data:type_name -> boostport.privacy.testing.ValidMultiplePersonalData.Nested
+	29, // 4: boostport.privacy.testing.ValidRetention.created_at:type_name -> google.protobuf.Timestamp
+	29, // 5: boostport.privacy.testing.ValidKeyBucket.created_at:type_name -> google.protobuf.Timestamp
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_boostport_privacy_testing_valid_proto_init() }
@@ -3127,7 +3955,7 @@ func file_boostport_privacy_testing_valid_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_boostport_privacy_testing_valid_proto_rawDesc), len(file_boostport_privacy_testing_valid_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,8 @@
+// Package sentinel defines sentinel errors of protoprivacy that are also needed by packages protoprivacy imports, so
+// that those packages can wrap them without an import cycle. protoprivacy exports them under the same names.
+package sentinel
+
+import "errors"
+
+// ErrCiphertextCorrupt signals that the ciphertext is malformed or failed authentication.
+var ErrCiphertextCorrupt = errors.New("ciphertext is corrupt")
@@ -0,0 +1,156 @@
+package protoprivacy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	"google.golang.org/protobuf/reflect/protopath"
+	"google.golang.org/protobuf/reflect/protorange"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// keyBucketSeparator separates the data subject id and the key bucket in the key scope passed to the crypter.
+const keyBucketSeparator = "@"
+
+// keyBucketEscaper escapes the key bucket separator in data subject ids using percent-encoding, so that the key scopes
+// of a data subject id only start with its own key bucket prefix and only the key bucket follows the separator.
+var keyBucketEscaper = strings.NewReplacer("%", "%25", keyBucketSeparator, "%40")
+
+// keyScope returns the key scope passed to the crypter for the data subject id and key bucket. The data subject id is
+// always escaped, so that the key scope of a data subject id without a key bucket cannot be the key scope of another
+// data subject id with a key bucket.
+func keyScope(dataSubjectID string, bucket string) string {
+	if bucket == "" {
+		return keyBucketEscaper.Replace(dataSubjectID)
+	}
+
+	return keyBucketPrefix(dataSubjectID) + bucket
+}
+
+// keyBucketPrefix returns the prefix shared by the key scopes of all key buckets of the data subject id. As the
+// separator is escaped in the data subject id, the prefix does not match the key scopes of other data subject ids with
+// key buckets.
+func keyBucketPrefix(dataSubjectID string) string {
+	return keyBucketEscaper.Replace(dataSubjectID) + keyBucketSeparator
+}
+
+// keyBucketSuffix returns the suffix shared by the key scopes of all data subject ids in the key bucket. As the
+// separator is escaped in data subject ids, the suffix does not match the key scopes of other key buckets.
+func keyBucketSuffix(bucket string) string {
+	return keyBucketSeparator + bucket
+}
+
+// envelopeKeyScope returns the key scope the encrypted data of the envelope was encrypted under. The key bucket stored
+// in the envelope is used if present, otherwise it is derived from the redacted message.
+func (p *Privacy) envelopeKeyScope(envelope *privacy.Envelope, m protoreflect.Message) (string, error) {
+	dataSubjectID, err := getDataSubjectID(m)
+	if err != nil {
+		return "", fmt.Errorf("error getting data subject id: %w", err)
+	}
+
+	if dataSubjectID == nil {
+		return "", errors.New("message does not contain a data subject id")
+	}
+
+	bucket := envelope.GetKeyBucket()
+	if !envelope.HasKeyBucket() {
+		bucket, _, err = keyBucket(m, p.clock())
+		if err != nil {
+			return "", fmt.Errorf("error getting key bucket: %w", err)
+		}
+	}
+
+	return keyScope(*dataSubjectID, bucket), nil
+}
+
+// keyBucket returns the key bucket of the data subject id in the message and whether it was derived from now because
+// the message does not have a key bucket timestamp. An empty bucket is returned if the data subject id does not use
+// key buckets.
+func keyBucket(m protoreflect.Message, now time.Time) (string, bool, error) {
+	var bucket string
+	var fromClock bool
+
+	err := protorange.Range(m, func(v protopath.Values) error {
+		privacyField, _ := getPrivacyFieldOptions(v)
+
+		if !privacyField.HasDataSubjectId() {
+			return nil
+		}
+
+		dataSubjectID := privacyField.GetDataSubjectId()
+
+		if dataSubjectID.GetKeyBucket() == privacy.PrivacyFieldOptions_DataSubjectID_KEY_BUCKET_UNSPECIFIED {
+			return protorange.Terminate
+		}
+
+		timestamp := now
+		fromClock = true
+
+		if name := dataSubjectID.GetKeyBucketTimestampField(); name != "" {
+			parentMessage, ok := v.Index(-2).Value.Interface().(protoreflect.Message)
+			if !ok {
+				return nil
+			}
+
+			fd := parentMessage.Descriptor().Fields().ByName(protoreflect.Name(name))
+			if parentMessage.Has(fd) {
+				timestamp = timestampValue(parentMessage.Get(fd).Message())
+				fromClock = false
+			}
+		}
+
+		bucket = formatKeyBucket(dataSubjectID.GetKeyBucket(), timestamp)
+
+		return protorange.Terminate
+	})
+
+	return bucket, fromClock, err
+}
+
+func formatKeyBucket(keyBucket privacy.PrivacyFieldOptions_DataSubjectID_KeyBucket, timestamp time.Time) string {
+	timestamp = timestamp.UTC()
+
+	switch keyBucket {
+	case privacy.PrivacyFieldOptions_DataSubjectID_KEY_BUCKET_MONTH:
+		return timestamp.Format("2006-01")
+	case privacy.PrivacyFieldOptions_DataSubjectID_KEY_BUCKET_QUARTER:
+		return fmt.Sprintf("%d-Q%d", timestamp.Year(), (int(timestamp.Month())-1)/3+1)
+	}
+
+	return ""
+}
+
+// timestampValue returns the time of a google.protobuf.Timestamp message.
+func timestampValue(m protoreflect.Message) time.Time {
+	fields := m.Descriptor().Fields()
+	return time.Unix(m.Get(fields.ByName("seconds")).Int(), m.Get(fields.ByName("nanos")).Int()).UTC()
+}
+
+func validateKeyBucket(f protoreflect.FieldDescriptor, dataSubjectID *privacy.PrivacyFieldOptions_DataSubjectID) error {
+	name := dataSubjectID.GetKeyBucketTimestampField()
+	if name == "" {
+		return nil
+	}
+
+	path := f.ParentFile().Path()
+
+	if dataSubjectID.GetKeyBucket() == privacy.PrivacyFieldOptions_DataSubjectID_KEY_BUCKET_UNSPECIFIED {
+		return fmt.Errorf("field %s has a key bucket timestamp field but does not have a key bucket in %s", f.FullName(), path)
+	}
+
+	timestampField := f.ContainingMessage().Fields().ByName(protoreflect.Name(name))
+
+	switch {
+	case timestampField == nil:
+		return fmt.Errorf("field %s has key bucket timestamp field %s that does not exist in %s", f.FullName(), name, path)
+	case timestampField.IsList() || timestampField.IsMap() || timestampField.Message() == nil || timestampField.Message().FullName() != timestampFullName:
+		return fmt.Errorf("field %s has key bucket timestamp field %s that is not a %s in %s", f.FullName(), timestampField.FullName(), timestampFullName, path)
+	case fieldHasPersonalData(timestampField):
+		return fmt.Errorf("field %s has key bucket timestamp field %s that is personal data in %s", f.FullName(), timestampField.FullName(), path)
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package protoprivacy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type scopeRecordingCrypter struct {
+	fakeCrypter
+	encryptScopes []string
+	decryptScopes []string
+}
+
+func (c *scopeRecordingCrypter) Encrypt(ctx context.Context, dataSubjectID string, plaintext []byte) ([]byte, error) {
+	c.encryptScopes = append(c.encryptScopes, dataSubjectID)
+	return c.fakeCrypter.Encrypt(ctx, dataSubjectID, plaintext)
+}
+
+func (c *scopeRecordingCrypter) Decrypt(ctx context.Context, dataSubjectID string, ciphertext []byte) ([]byte, error) {
+	c.decryptScopes = append(c.decryptScopes, dataSubjectID)
+	return c.fakeCrypter.Decrypt(ctx, dataSubjectID, ciphertext)
+}
+
+func TestKeyBucket(t *testing.T) {
+	createdAt := time.Date(2025, 2, 14, 10, 0, 0, 0, time.UTC)
+	now := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		explanation       string
+		proto             proto.Message
+		expectedScope     string
+		expectedKeyBucket string
+	}{
+		{
+			explanation: "Month bucket from timestamp field",
+			proto: testprotos.TestKeyBucket_builder{
+				Id:        proto.String("123"),
+				CreatedAt: timestamppb.New(createdAt),
+				Data1:     proto.String("test"),
+			}.Build(),
+			expectedScope: "user:123@2025-02",
+		},
+		{
+			explanation: "Month bucket from clock when timestamp field is not set",
+			proto: testprotos.TestKeyBucket_builder{
+				Id:    proto.String("123"),
+				Data1: proto.String("test"),
+			}.Build(),
+			expectedScope:     "user:123@2025-08",
+			expectedKeyBucket: "2025-08",
+		},
+		{
+			explanation: "Key bucket separator in data subject id is escaped",
+			proto: testprotos.TestKeyBucket_builder{
+				Id:        proto.String("john@example.com%"),
+				CreatedAt: timestamppb.New(createdAt),
+				Data1:     proto.String("test"),
+			}.Build(),
+			expectedScope: "user:john%40example.com%25@2025-02",
+		},
+		{
+			explanation: "Quarter bucket from clock",
+			proto: testprotos.TestKeyBucketFromClock_builder{
+				Id:    proto.String("123"),
+				Data1: proto.String("test"),
+			}.Build(),
+			expectedScope:     "123@2025-Q3",
+			expectedKeyBucket: "2025-Q3",
+		},
+		{
+			explanation: "No bucket",
+			proto: testprotos.TestMessage_builder{
+				Id:    proto.String("123"),
+				Data1: proto.String("test"),
+			}.Build(),
+			expectedScope: "123",
+		},
+		{
+			explanation: "No bucket with escaped data subject id",
+			proto: testprotos.TestMessage_builder{
+				Id:    proto.String("john@2025-02"),
+				Data1: proto.String("test"),
+			}.Build(),
+			expectedScope: "john%402025-02",
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			crypter := &scopeRecordingCrypter{}
+			clock := now
+			p := New(crypter, WithClock(func() time.Time { return clock }))
+
+			encrypted, err := p.Encrypt(context.Background(), tt.proto)
+			if err != nil {
+				t.Fatalf("Error encrypting message: %v", err)
+			}
+
+			envelope := encrypted.(*privacy.Envelope)
+
+			if envelope.GetKeyBucket() != tt.expectedKeyBucket {
+				t.Errorf("Expected key bucket %q in envelope, got %q", tt.expectedKeyBucket, envelope.GetKeyBucket())
+			}
+
+			// Decrypting in a later bucket must derive the same scope
+			clock = now.AddDate(1, 0, 0)
+
+			decrypted, err := p.Decrypt(context.Background(), encrypted)
+			if err != nil {
+				t.Fatalf("Error decrypting message: %v", err)
+			}
+
+			if !proto.Equal(decrypted, tt.proto) {
+				t.Errorf("Decrypted message does not match original message, expected %v, got %v", tt.proto, decrypted)
+			}
+
+			if crypter.encryptScopes[0] != tt.expectedScope {
+				t.Errorf("Expected encrypt key scope %q, got %q", tt.expectedScope, crypter.encryptScopes[0])
+			}
+
+			if crypter.decryptScopes[0] != tt.expectedScope {
+				t.Errorf("Expected decrypt key scope %q, got %q", tt.expectedScope, crypter.decryptScopes[0])
+			}
+		})
+	}
+}
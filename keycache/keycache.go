@@ -0,0 +1,366 @@
+// Package keycache provides a protoprivacy.Crypter that caches the key handles of data subjects, so that decrypting
+// many messages of the same data subject does not fetch its key every time.
+//
+// Key handles are cached in a size-limited least recently used cache with a time to live. Concurrent lookups of the
+// same data subject are deduplicated. Shredding a data subject through the Crypter evicts it from the cache
+// immediately, so a cached key never outlives a deletion. To evict shredded data subjects from the caches of other
+// replicas of a service, use WithShredNotifier with a protoprivacy.ShredNotifier connected to your message bus.
+package keycache
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Boostport/protoprivacy"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	defaultSize = 10000
+	defaultTTL  = 5 * time.Minute
+)
+
+// KeyHandle encrypts and decrypts data for a single data subject using its key. Key handles must be safe for concurrent
+// use.
+type KeyHandle interface {
+	Encrypt(ctx context.Context, cleartext []byte) ([]byte, error)
+	Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error)
+}
+
+// KeyHandleProvider returns the key handles of data subjects. If create is true, a key is created if the data subject
+// does not have one. Otherwise, protoprivacy.ErrKeyShredded or protoprivacy.ErrKeyNotFound is returned.
+type KeyHandleProvider interface {
+	KeyHandle(ctx context.Context, dataSubjectID string, create bool) (KeyHandle, error)
+}
+
+// Option configures a Crypter.
+type Option func(*Crypter)
+
+// WithSize sets the maximum number of cached key handles. The least recently used key handle is evicted when the cache
+// is full. The default is 10000.
+func WithSize(size int) Option {
+	return func(c *Crypter) {
+		c.size = size
+	}
+}
+
+// WithShredNotifier subscribes the Crypter to shred events published by notifier, evicting shredded data subjects from
+// the cache, and publishes an event whenever a data subject is shredded through the Crypter. Call Close to unsubscribe.
+func WithShredNotifier(notifier protoprivacy.ShredNotifier) Option {
+	return func(c *Crypter) {
+		c.notifier = notifier
+	}
+}
+
+// WithTTL sets how long key handles are cached. The default is 5 minutes.
+func WithTTL(ttl time.Duration) Option {
+	return func(c *Crypter) {
+		c.ttl = ttl
+	}
+}
+
+type entry struct {
+	dataSubjectID string
+	handle        KeyHandle
+	expires       time.Time
+}
+
+// Crypter caches the key handles returned by a KeyHandleProvider. It implements protoprivacy.Crypter, and implements
+// protoprivacy.Shredder and protoprivacy.SuffixShredder if the provider does.
+type Crypter struct {
+	provider KeyHandleProvider
+	size     int
+	ttl      time.Duration
+	now      func() time.Time
+	group    singleflight.Group
+	notifier protoprivacy.ShredNotifier
+
+	unsubscribe func()
+
+	mu         sync.Mutex
+	entries    map[string]*list.Element
+	lru        *list.List
+	generation uint64
+}
+
+// New returns a Crypter that caches the key handles returned by provider.
+func New(provider KeyHandleProvider, opts ...Option) *Crypter {
+	c := &Crypter{
+		provider: provider,
+		size:     defaultSize,
+		ttl:      defaultTTL,
+		now:      time.Now,
+		entries:  make(map[string]*list.Element),
+		lru:      list.New(),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.notifier != nil {
+		c.unsubscribe = c.notifier.Subscribe(func(event protoprivacy.ShredEvent) {
+			c.invalidate(event.Matches)
+		})
+	}
+
+	return c
+}
+
+// Close unsubscribes the Crypter from its shred notifier, if any.
+func (c *Crypter) Close() {
+	if c.unsubscribe != nil {
+		c.unsubscribe()
+	}
+}
+
+func (c *Crypter) Encrypt(ctx context.Context, dataSubjectID string, cleartext []byte) ([]byte, error) {
+	handle, err := c.keyHandle(ctx, dataSubjectID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return handle.Encrypt(ctx, cleartext)
+}
+
+func (c *Crypter) Decrypt(ctx context.Context, dataSubjectID string, ciphertext []byte) ([]byte, error) {
+	handle, err := c.keyHandle(ctx, dataSubjectID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return handle.Decrypt(ctx, ciphertext)
+}
+
+// Shred evicts the data subject id from the cache and shreds it using the provider, which must implement
+// protoprivacy.Shredder.
+func (c *Crypter) Shred(ctx context.Context, dataSubjectID string) error {
+	shredder, err := c.shredder()
+	if err != nil {
+		return err
+	}
+
+	c.Invalidate(dataSubjectID)
+
+	// Evict again in case a lookup cached the key handle while it was being shredded
+	defer c.Invalidate(dataSubjectID)
+
+	if err := shredder.Shred(ctx, dataSubjectID); err != nil {
+		return err
+	}
+
+	return c.publish(ctx, protoprivacy.ShredEvent{DataSubjectID: dataSubjectID})
+}
+
+// ShredPrefix evicts the data subject ids starting with the prefix from the cache and shreds them using the provider,
+// which must implement protoprivacy.Shredder.
+func (c *Crypter) ShredPrefix(ctx context.Context, prefix string) error {
+	shredder, err := c.shredder()
+	if err != nil {
+		return err
+	}
+
+	c.InvalidatePrefix(prefix)
+	defer c.InvalidatePrefix(prefix)
+
+	if err := shredder.ShredPrefix(ctx, prefix); err != nil {
+		return err
+	}
+
+	return c.publish(ctx, protoprivacy.ShredEvent{Prefix: prefix, IsPrefix: true})
+}
+
+// ShredSuffix evicts the data subject ids ending with the suffix from the cache and shreds them using the provider,
+// which must implement protoprivacy.SuffixShredder.
+func (c *Crypter) ShredSuffix(ctx context.Context, suffix string) error {
+	shredder, ok := c.provider.(protoprivacy.SuffixShredder)
+	if !ok {
+		return errors.New("key handle provider does not implement SuffixShredder")
+	}
+
+	c.InvalidateSuffix(suffix)
+	defer c.InvalidateSuffix(suffix)
+
+	if err := shredder.ShredSuffix(ctx, suffix); err != nil {
+		return err
+	}
+
+	return c.publish(ctx, protoprivacy.ShredEvent{Suffix: suffix, IsSuffix: true})
+}
+
+// IsShredded reports whether the data subject id is shredded using the provider, which must implement
+// protoprivacy.Shredder. The cache is not consulted.
+func (c *Crypter) IsShredded(ctx context.Context, dataSubjectID string) (bool, error) {
+	shredder, err := c.shredder()
+	if err != nil {
+		return false, err
+	}
+
+	return shredder.IsShredded(ctx, dataSubjectID)
+}
+
+// Invalidate evicts the key handle of the data subject id from the cache.
+func (c *Crypter) Invalidate(dataSubjectID string) {
+	c.invalidate(func(id string) bool {
+		return id == dataSubjectID
+	})
+}
+
+// InvalidatePrefix evicts the key handles of the data subject ids starting with the prefix from the cache.
+func (c *Crypter) InvalidatePrefix(prefix string) {
+	c.invalidate(func(id string) bool {
+		return strings.HasPrefix(id, prefix)
+	})
+}
+
+// InvalidateSuffix evicts the key handles of the data subject ids ending with the suffix from the cache.
+func (c *Crypter) InvalidateSuffix(suffix string) {
+	c.invalidate(func(id string) bool {
+		return strings.HasSuffix(id, suffix)
+	})
+}
+
+// InvalidateAll evicts all key handles from the cache.
+func (c *Crypter) InvalidateAll() {
+	c.invalidate(func(string) bool {
+		return true
+	})
+}
+
+func (c *Crypter) invalidate(match func(dataSubjectID string) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Lookups that started before the invalidation must not cache their key handles
+	c.generation++
+
+	for id, element := range c.entries {
+		if match(id) {
+			c.lru.Remove(element)
+			delete(c.entries, id)
+		}
+	}
+}
+
+func (c *Crypter) publish(ctx context.Context, event protoprivacy.ShredEvent) error {
+	if c.notifier == nil {
+		return nil
+	}
+
+	if err := c.notifier.Publish(ctx, event); err != nil {
+		return fmt.Errorf("error publishing shred event: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Crypter) shredder() (protoprivacy.Shredder, error) {
+	shredder, ok := c.provider.(protoprivacy.Shredder)
+	if !ok {
+		return nil, errors.New("key handle provider does not implement Shredder")
+	}
+
+	return shredder, nil
+}
+
+func (c *Crypter) keyHandle(ctx context.Context, dataSubjectID string, create bool) (KeyHandle, error) {
+	if handle := c.get(dataSubjectID); handle != nil {
+		return handle, nil
+	}
+
+	// Lookups that create keys are deduplicated separately, as lookups that do not create keys can fail
+	key := "get:" + dataSubjectID
+	if create {
+		key = "create:" + dataSubjectID
+	}
+
+	// The lookup is shared by all callers, so it must not be canceled when the caller that started it is canceled.
+	// Each caller stops waiting for it when its own context is canceled instead.
+	lookupCtx := context.WithoutCancel(ctx)
+
+	result := c.group.DoChan(key, func() (any, error) {
+		c.mu.Lock()
+		generation := c.generation
+		c.mu.Unlock()
+
+		handle, err := c.provider.KeyHandle(lookupCtx, dataSubjectID, create)
+		if err != nil {
+			return nil, err
+		}
+
+		c.put(dataSubjectID, handle, generation)
+
+		return handle, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("error getting key handle: %w", ctx.Err())
+	case r := <-result:
+		if r.Err != nil {
+			return nil, fmt.Errorf("error getting key handle: %w", r.Err)
+		}
+
+		return r.Val.(KeyHandle), nil
+	}
+}
+
+func (c *Crypter) get(dataSubjectID string) KeyHandle {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[dataSubjectID]
+	if !ok {
+		return nil
+	}
+
+	e := element.Value.(*entry)
+
+	if !c.now().Before(e.expires) {
+		c.lru.Remove(element)
+		delete(c.entries, dataSubjectID)
+		return nil
+	}
+
+	c.lru.MoveToFront(element)
+
+	return e.handle
+}
+
+func (c *Crypter) put(dataSubjectID string, handle KeyHandle, generation uint64) {
+	if c.size <= 0 || c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	e := &entry{
+		dataSubjectID: dataSubjectID,
+		handle:        handle,
+		expires:       c.now().Add(c.ttl),
+	}
+
+	if element, ok := c.entries[dataSubjectID]; ok {
+		element.Value = e
+		c.lru.MoveToFront(element)
+		return
+	}
+
+	c.entries[dataSubjectID] = c.lru.PushFront(e)
+
+	for c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*entry).dataSubjectID)
+	}
+}
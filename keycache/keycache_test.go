@@ -0,0 +1,479 @@
+package keycache
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Boostport/protoprivacy"
+	"github.com/Boostport/protoprivacy/crypttest"
+	"github.com/Boostport/protoprivacy/internal/aead"
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+)
+
+// countingProvider is a KeyHandleProvider that keeps keys in memory and counts key handle lookups.
+type countingProvider struct {
+	mu      sync.Mutex
+	keys    map[string][]byte
+	lookups atomic.Int32
+	block   chan struct{}
+}
+
+func newCountingProvider() *countingProvider {
+	return &countingProvider{
+		keys: make(map[string][]byte),
+	}
+}
+
+func (p *countingProvider) KeyHandle(ctx context.Context, dataSubjectID string, create bool) (KeyHandle, error) {
+	p.lookups.Add(1)
+
+	if p.block != nil {
+		select {
+		case <-p.block:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key, ok := p.keys[dataSubjectID]
+	if !ok && !create {
+		return nil, protoprivacy.ErrKeyShredded
+	}
+
+	if !ok {
+		var err error
+
+		key, err = aead.NewKey()
+		if err != nil {
+			return nil, err
+		}
+
+		p.keys[dataSubjectID] = key
+	}
+
+	return aead.NewHandle(key, dataSubjectID), nil
+}
+
+func (p *countingProvider) Shred(_ context.Context, dataSubjectID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.keys, dataSubjectID)
+	return nil
+}
+
+func (p *countingProvider) ShredPrefix(_ context.Context, prefix string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for id := range p.keys {
+		if strings.HasPrefix(id, prefix) {
+			delete(p.keys, id)
+		}
+	}
+	return nil
+}
+
+func (p *countingProvider) ShredSuffix(_ context.Context, suffix string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for id := range p.keys {
+		if strings.HasSuffix(id, suffix) {
+			delete(p.keys, id)
+		}
+	}
+	return nil
+}
+
+func (p *countingProvider) IsShredded(_ context.Context, dataSubjectID string) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, ok := p.keys[dataSubjectID]
+	return !ok, nil
+}
+
+type nonShreddingProvider struct {
+	KeyHandleProvider
+}
+
+func roundTrip(t *testing.T, c *Crypter, dataSubjectID string) {
+	t.Helper()
+
+	ciphertext, err := c.Encrypt(context.Background(), dataSubjectID, []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	cleartext, err := c.Decrypt(context.Background(), dataSubjectID, ciphertext)
+	if err != nil {
+		t.Fatalf("Error decrypting: %v", err)
+	}
+
+	if !bytes.Equal(cleartext, []byte("test")) {
+		t.Errorf("Expected cleartext %q, got %q", "test", cleartext)
+	}
+}
+
+func TestCrypterCaches(t *testing.T) {
+	provider := newCountingProvider()
+	c := New(provider)
+
+	for range 10 {
+		roundTrip(t, c, "user:123")
+	}
+
+	if lookups := provider.lookups.Load(); lookups != 1 {
+		t.Errorf("Expected 1 key handle lookup, got %d", lookups)
+	}
+}
+
+func TestCrypterTTL(t *testing.T) {
+	provider := newCountingProvider()
+	c := New(provider, WithTTL(time.Minute))
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+
+	roundTrip(t, c, "user:123")
+
+	now = now.Add(2 * time.Minute)
+
+	roundTrip(t, c, "user:123")
+
+	if lookups := provider.lookups.Load(); lookups != 2 {
+		t.Errorf("Expected 2 key handle lookups after expiry, got %d", lookups)
+	}
+}
+
+func TestCrypterLRU(t *testing.T) {
+	provider := newCountingProvider()
+	c := New(provider, WithSize(2))
+
+	roundTrip(t, c, "user:1")
+	roundTrip(t, c, "user:2")
+	roundTrip(t, c, "user:1")
+	roundTrip(t, c, "user:3") // Evicts user:2, the least recently used
+
+	provider.lookups.Store(0)
+
+	roundTrip(t, c, "user:1")
+	roundTrip(t, c, "user:3")
+
+	if lookups := provider.lookups.Load(); lookups != 0 {
+		t.Errorf("Expected recently used key handles to be cached, got %d lookups", lookups)
+	}
+
+	roundTrip(t, c, "user:2")
+
+	if lookups := provider.lookups.Load(); lookups != 1 {
+		t.Errorf("Expected least recently used key handle to be evicted, got %d lookups", lookups)
+	}
+}
+
+func TestCrypterSingleflight(t *testing.T) {
+	provider := newCountingProvider()
+	c := New(provider)
+
+	roundTrip(t, c, "user:123")
+	c.Invalidate("user:123")
+	provider.lookups.Store(0)
+	provider.block = make(chan struct{})
+
+	ciphertext, err := aead.NewHandle(provider.keys["user:123"], "user:123").Encrypt(context.Background(), []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := c.Decrypt(context.Background(), "user:123", ciphertext); err != nil {
+				t.Errorf("Error decrypting: %v", err)
+			}
+		}()
+	}
+
+	// Give the goroutines time to join the in-flight lookup before unblocking it
+	time.Sleep(10 * time.Millisecond)
+	close(provider.block)
+	wg.Wait()
+
+	if lookups := provider.lookups.Load(); lookups != 1 {
+		t.Errorf("Expected concurrent lookups to be deduplicated, got %d lookups", lookups)
+	}
+}
+
+func TestCrypterSingleflightCanceled(t *testing.T) {
+	provider := newCountingProvider()
+	c := New(provider)
+
+	roundTrip(t, c, "user:123")
+	c.Invalidate("user:123")
+	provider.block = make(chan struct{})
+
+	ciphertext, err := aead.NewHandle(provider.keys["user:123"], "user:123").Encrypt(context.Background(), []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error)
+
+	// The first caller starts the lookup and is canceled while the second caller waits for it
+	go func() {
+		_, err := c.Decrypt(ctx, "user:123", ciphertext)
+		canceled <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+
+	decrypted := make(chan error)
+
+	go func() {
+		_, err := c.Decrypt(context.Background(), "user:123", ciphertext)
+		decrypted <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	if err := <-canceled; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected canceled caller to get context.Canceled, got %v", err)
+	}
+
+	close(provider.block)
+
+	if err := <-decrypted; err != nil {
+		t.Errorf("Expected caller with live context to decrypt after another caller was canceled, got %v", err)
+	}
+}
+
+func TestCrypterShred(t *testing.T) {
+	ctx := context.Background()
+	c := New(newCountingProvider())
+
+	ciphertext, err := c.Encrypt(ctx, "user:123", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if err := c.Shred(ctx, "user:123"); err != nil {
+		t.Fatalf("Error shredding data subject: %v", err)
+	}
+
+	if _, err := c.Decrypt(ctx, "user:123", ciphertext); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected ErrKeyShredded after shredding, got %v", err)
+	}
+
+	if shredded, err := c.IsShredded(ctx, "user:123"); err != nil || !shredded {
+		t.Errorf("Expected data subject to be shredded, got %t, %v", shredded, err)
+	}
+
+	ciphertext, err = c.Encrypt(ctx, "user:456", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if err := c.ShredPrefix(ctx, "user:"); err != nil {
+		t.Fatalf("Error shredding prefix: %v", err)
+	}
+
+	if _, err := c.Decrypt(ctx, "user:456", ciphertext); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected ErrKeyShredded after shredding prefix, got %v", err)
+	}
+
+	if err := New(nonShreddingProvider{newCountingProvider()}).Shred(ctx, "user:123"); err == nil {
+		t.Error("Expected error shredding with provider that does not implement Shredder")
+	}
+}
+
+func TestCrypterInvalidate(t *testing.T) {
+	provider := newCountingProvider()
+	c := New(provider)
+
+	roundTrip(t, c, "user:1")
+	roundTrip(t, c, "user:2")
+	roundTrip(t, c, "employee:1")
+
+	c.InvalidatePrefix("user:")
+	provider.lookups.Store(0)
+
+	roundTrip(t, c, "employee:1")
+	roundTrip(t, c, "user:1")
+	roundTrip(t, c, "user:2")
+
+	if lookups := provider.lookups.Load(); lookups != 2 {
+		t.Errorf("Expected 2 lookups for invalidated data subjects, got %d", lookups)
+	}
+
+	c.InvalidateAll()
+	provider.lookups.Store(0)
+
+	roundTrip(t, c, "employee:1")
+
+	if lookups := provider.lookups.Load(); lookups != 1 {
+		t.Errorf("Expected 1 lookup after invalidating all, got %d", lookups)
+	}
+}
+
+func TestCrypterWithPrivacy(t *testing.T) {
+	ctx := context.Background()
+	p := protoprivacy.New(New(newCountingProvider()))
+
+	msg := testprotos.TestMessage_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("test"),
+	}.Build()
+
+	encrypted, err := p.Encrypt(ctx, msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	if err := p.Shred(ctx, encrypted); err != nil {
+		t.Fatalf("Error shredding data subject: %v", err)
+	}
+
+	decrypted, report, err := p.DecryptWithReport(ctx, encrypted)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if report.Outcome != protoprivacy.DecryptOutcomeKeyShredded {
+		t.Errorf("Expected outcome %s, got %s", protoprivacy.DecryptOutcomeKeyShredded, report.Outcome)
+	}
+
+	if got := decrypted.(*testprotos.TestMessage).GetData1(); got != "" {
+		t.Errorf("Expected personal data to be cleared, got %q", got)
+	}
+}
+
+// fakeBus simulates a message bus connecting the shred notifiers of several replicas.
+type fakeBus struct {
+	nodes []*protoprivacy.LocalShredNotifier
+}
+
+// fakeBusNotifier is the shred notifier of a single replica connected to a fakeBus.
+type fakeBusNotifier struct {
+	bus   *fakeBus
+	local *protoprivacy.LocalShredNotifier
+}
+
+func (b *fakeBus) join() fakeBusNotifier {
+	local := protoprivacy.NewLocalShredNotifier()
+	b.nodes = append(b.nodes, local)
+
+	return fakeBusNotifier{
+		bus:   b,
+		local: local,
+	}
+}
+
+func (n fakeBusNotifier) Publish(ctx context.Context, event protoprivacy.ShredEvent) error {
+	for _, node := range n.bus.nodes {
+		if err := node.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (n fakeBusNotifier) Subscribe(handler func(event protoprivacy.ShredEvent)) func() {
+	return n.local.Subscribe(handler)
+}
+
+func TestCrypterShredNotifier(t *testing.T) {
+	ctx := context.Background()
+	bus := &fakeBus{}
+
+	// Both replicas use the same key service but have their own caches
+	provider := newCountingProvider()
+	replica1 := New(provider, WithShredNotifier(bus.join()))
+	replica2 := New(provider, WithShredNotifier(bus.join()))
+	defer replica1.Close()
+	defer replica2.Close()
+
+	ciphertext1, err := replica1.Encrypt(ctx, "user:1", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	ciphertext2, err := replica1.Encrypt(ctx, "user:2", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	// Cache the key handles on the second replica
+	for id, ciphertext := range map[string][]byte{"user:1": ciphertext1, "user:2": ciphertext2} {
+		if _, err := replica2.Decrypt(ctx, id, ciphertext); err != nil {
+			t.Fatalf("Error decrypting: %v", err)
+		}
+	}
+
+	if err := replica1.Shred(ctx, "user:1"); err != nil {
+		t.Fatalf("Error shredding data subject: %v", err)
+	}
+
+	if _, err := replica2.Decrypt(ctx, "user:1", ciphertext1); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected other replica to evict shredded data subject, got %v", err)
+	}
+
+	if err := replica1.ShredPrefix(ctx, "user:"); err != nil {
+		t.Fatalf("Error shredding prefix: %v", err)
+	}
+
+	if _, err := replica2.Decrypt(ctx, "user:2", ciphertext2); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected other replica to evict shredded prefix, got %v", err)
+	}
+}
+
+func TestCrypterShredNotifierEmptyPrefix(t *testing.T) {
+	ctx := context.Background()
+	bus := &fakeBus{}
+
+	provider := newCountingProvider()
+	replica1 := New(provider, WithShredNotifier(bus.join()))
+	replica2 := New(provider, WithShredNotifier(bus.join()))
+	defer replica1.Close()
+	defer replica2.Close()
+
+	ciphertext, err := replica1.Encrypt(ctx, "user:1", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	// Cache the key handle on the second replica
+	if _, err := replica2.Decrypt(ctx, "user:1", ciphertext); err != nil {
+		t.Fatalf("Error decrypting: %v", err)
+	}
+
+	if err := replica1.ShredPrefix(ctx, ""); err != nil {
+		t.Fatalf("Error shredding prefix: %v", err)
+	}
+
+	if _, err := replica2.Decrypt(ctx, "user:1", ciphertext); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected other replica to evict all data subjects, got %v", err)
+	}
+}
+
+func TestConformance(t *testing.T) {
+	crypttest.TestCrypter(t, func(t *testing.T) protoprivacy.Crypter {
+		return New(newCountingProvider())
+	})
+}
@@ -0,0 +1,220 @@
+package keystore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// maxEncodedIDSize is the maximum size of an encoded id that is used as the name of its key file. Longer ids are
+// hashed, as most file systems limit file names to 255 bytes.
+const maxEncodedIDSize = 200
+
+// hashedIDPrefix is the prefix of the names of key files of hashed ids. It is not part of the alphabet of encoded ids.
+const hashedIDPrefix = "sha256."
+
+// File is a KeyStore that keeps each key in its own file in a directory. Key files are created with 0600 permissions
+// and are written atomically, so a key is either fully present or absent.
+//
+// Keys are stored in plaintext, so anyone who can read the directory or its backups can decrypt the data of all data
+// subjects. Restrict access to the directory and keep it on an encrypted file system, or use dek.Crypter to wrap keys
+// using a key management service before they are stored.
+type File struct {
+	dir string
+}
+
+// NewFile returns a KeyStore that stores keys in dir. The directory is created if it does not exist.
+func NewFile(dir string) (*File, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("error creating key directory: %w", err)
+	}
+
+	return &File{
+		dir: dir,
+	}, nil
+}
+
+func (f *File) Get(ctx context.Context, id string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	contents, err := os.ReadFile(f.path(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading key: %w", err)
+	}
+
+	if !isHashedID(id) {
+		return contents, nil
+	}
+
+	storedID, key, err := splitHashedContents(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	if storedID != id {
+		return nil, fmt.Errorf("key file of id %q contains the key of another id", id)
+	}
+
+	return key, nil
+}
+
+func (f *File) Create(ctx context.Context, id string, key []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("error creating temporary key file: %w", err)
+	}
+
+	defer os.Remove(tmp.Name())
+
+	contents := key
+	if isHashedID(id) {
+		// The id cannot be recovered from the hashed file name, so it is stored along with the key for DeletePrefix
+		contents = binary.AppendUvarint(nil, uint64(len(id)))
+		contents = append(contents, id...)
+		contents = append(contents, key...)
+	}
+
+	_, err = tmp.Write(contents)
+	if err == nil {
+		err = tmp.Sync()
+	}
+
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("error writing temporary key file: %w", err)
+	}
+
+	// Linking fails if the key file already exists, which makes creating keys safe against concurrent writers
+	err = os.Link(tmp.Name(), f.path(id))
+	if errors.Is(err, os.ErrExist) {
+		return ErrKeyExists
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating key file: %w", err)
+	}
+
+	return nil
+}
+
+func (f *File) Delete(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	err := os.Remove(f.path(id))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error deleting key file: %w", err)
+	}
+
+	return nil
+}
+
+func (f *File) DeletePrefix(ctx context.Context, prefix string) error {
+	return f.deleteMatching(ctx, func(id string) bool {
+		return strings.HasPrefix(id, prefix)
+	})
+}
+
+func (f *File) DeleteSuffix(ctx context.Context, suffix string) error {
+	return f.deleteMatching(ctx, func(id string) bool {
+		return strings.HasSuffix(id, suffix)
+	})
+}
+
+// deleteMatching deletes the keys with ids matching the function.
+func (f *File) deleteMatching(ctx context.Context, match func(id string) bool) error {
+	entries, err := os.ReadDir(f.dir)
+	if err != nil {
+		return fmt.Errorf("error reading key directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		id, ok, err := f.entryID(entry.Name())
+		if err != nil {
+			return err
+		}
+
+		if !ok || !match(id) {
+			continue
+		}
+
+		if err := f.Delete(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// entryID returns the id of the key in the file with the name. Files that are not key files, such as temporary key
+// files, are skipped by returning false.
+func (f *File) entryID(name string) (string, bool, error) {
+	if !strings.HasPrefix(name, hashedIDPrefix) {
+		// Temporary key files are not valid encoded ids
+		id, err := hex.DecodeString(name)
+		return string(id), err == nil, nil
+	}
+
+	contents, err := os.ReadFile(filepath.Join(f.dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", false, nil
+	}
+
+	if err != nil {
+		return "", false, fmt.Errorf("error reading key: %w", err)
+	}
+
+	id, _, err := splitHashedContents(contents)
+	if err != nil {
+		return "", false, err
+	}
+
+	return id, true, nil
+}
+
+// path returns the path of the key file. Ids are hex encoded so that they can contain characters that are not allowed
+// in file names, such as the separators used in data subject id prefixes, and so that ids differing only in case do not
+// share a key file on case-insensitive file systems. Ids that are too long to be used as file names are hashed.
+func (f *File) path(id string) string {
+	if isHashedID(id) {
+		sum := sha256.Sum256([]byte(id))
+		return filepath.Join(f.dir, hashedIDPrefix+hex.EncodeToString(sum[:]))
+	}
+
+	return filepath.Join(f.dir, hex.EncodeToString([]byte(id)))
+}
+
+// isHashedID reports whether the key file of the id is named using the hash of the id.
+func isHashedID(id string) bool {
+	return hex.EncodedLen(len(id)) > maxEncodedIDSize
+}
+
+// splitHashedContents splits the contents of the key file of a hashed id into the id and the key.
+func splitHashedContents(contents []byte) (string, []byte, error) {
+	size, n := binary.Uvarint(contents)
+	if n <= 0 || uint64(len(contents)-n) < size {
+		return "", nil, errors.New("invalid key file")
+	}
+
+	return string(contents[n : n+int(size)]), contents[n+int(size):], nil
+}
@@ -0,0 +1,82 @@
+// Package keystore provides storage for the keys used by the crypters in this module.
+//
+// The stores in this package keep keys in plaintext. Anyone who can read a File store's directory, or its backups, can
+// decrypt the data of every data subject. Use dek.Crypter to wrap keys using a key management service before they are
+// stored, or restrict access to the directory and keep it on an encrypted file system.
+package keystore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrKeyExists is returned by Create if a key with the same id already exists.
+var ErrKeyExists = errors.New("key already exists")
+
+// KeyStore stores keys by id. Implementations must be safe for concurrent use.
+type KeyStore interface {
+	// Get returns the key with the id. If the key does not exist or has been deleted, nil is returned as the key and
+	// nil as the error (nil, nil).
+	Get(ctx context.Context, id string) ([]byte, error)
+
+	// Create stores a new key with the id. If a key with the id already exists, ErrKeyExists is returned and the
+	// existing key is left unchanged.
+	Create(ctx context.Context, id string, key []byte) error
+
+	// Delete deletes the key with the id. Deleting a key that does not exist is not an error.
+	Delete(ctx context.Context, id string) error
+
+	// DeletePrefix deletes all keys with ids starting with the prefix.
+	DeletePrefix(ctx context.Context, prefix string) error
+}
+
+// SuffixDeleter is implemented by key stores that can delete all keys with ids ending with a suffix, which crypters
+// need to shred the keys of all data subjects in a key bucket.
+type SuffixDeleter interface {
+	// DeleteSuffix deletes all keys with ids ending with the suffix.
+	DeleteSuffix(ctx context.Context, suffix string) error
+}
+
+// DeleteSuffix deletes all keys with ids ending with the suffix from the store, which must implement SuffixDeleter.
+func DeleteSuffix(ctx context.Context, store KeyStore, suffix string) error {
+	deleter, ok := store.(SuffixDeleter)
+	if !ok {
+		return errors.New("key store does not implement SuffixDeleter")
+	}
+
+	return deleter.DeleteSuffix(ctx, suffix)
+}
+
+// GetOrCreate returns the key with the id from the store. If the key does not exist, a key returned by newKey is
+// created. If another writer creates the key concurrently, their key is returned, so that all writers use the same key.
+func GetOrCreate(ctx context.Context, store KeyStore, id string, newKey func() ([]byte, error)) ([]byte, error) {
+	key, err := store.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting key: %w", err)
+	}
+
+	if key != nil {
+		return key, nil
+	}
+
+	key, err = newKey()
+	if err != nil {
+		return nil, err
+	}
+
+	err = store.Create(ctx, id, key)
+	if errors.Is(err, ErrKeyExists) {
+		// Another writer created the key concurrently, so use theirs
+		key, err = store.Get(ctx, id)
+		if err == nil && key == nil {
+			err = errors.New("key was deleted while being created")
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating key: %w", err)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,323 @@
+package keystore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestKeyStores(t *testing.T) {
+	for _, tt := range []struct {
+		explanation string
+		newStore    func(t *testing.T) KeyStore
+	}{
+		{
+			explanation: "Memory",
+			newStore: func(t *testing.T) KeyStore {
+				return NewMemory()
+			},
+		},
+		{
+			explanation: "File",
+			newStore: func(t *testing.T) KeyStore {
+				store, err := NewFile(t.TempDir())
+				if err != nil {
+					t.Fatalf("Error creating file key store: %v", err)
+				}
+				return store
+			},
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			ctx := context.Background()
+			store := tt.newStore(t)
+
+			key, err := store.Get(ctx, "user:123")
+			if err != nil {
+				t.Fatalf("Error getting missing key: %v", err)
+			}
+
+			if key != nil {
+				t.Errorf("Expected nil key for missing key, got %v", key)
+			}
+
+			if err := store.Create(ctx, "user:123", []byte("key1")); err != nil {
+				t.Fatalf("Error creating key: %v", err)
+			}
+
+			if err := store.Create(ctx, "user:123", []byte("key2")); !errors.Is(err, ErrKeyExists) {
+				t.Errorf("Expected ErrKeyExists when creating existing key, got %v", err)
+			}
+
+			key, err = store.Get(ctx, "user:123")
+			if err != nil {
+				t.Fatalf("Error getting key: %v", err)
+			}
+
+			if !bytes.Equal(key, []byte("key1")) {
+				t.Errorf("Expected key %q, got %q", "key1", key)
+			}
+
+			if err := store.Delete(ctx, "user:123"); err != nil {
+				t.Fatalf("Error deleting key: %v", err)
+			}
+
+			if err := store.Delete(ctx, "user:123"); err != nil {
+				t.Errorf("Unexpected error deleting missing key: %v", err)
+			}
+
+			key, err = store.Get(ctx, "user:123")
+			if err != nil {
+				t.Fatalf("Error getting deleted key: %v", err)
+			}
+
+			if key != nil {
+				t.Errorf("Expected nil key for deleted key, got %v", key)
+			}
+
+			for _, id := range []string{"user:123", "user:456", "employee:123"} {
+				if err := store.Create(ctx, id, []byte("key")); err != nil {
+					t.Fatalf("Error creating key: %v", err)
+				}
+			}
+
+			if err := store.DeletePrefix(ctx, "user:"); err != nil {
+				t.Fatalf("Error deleting keys by prefix: %v", err)
+			}
+
+			for id, exists := range map[string]bool{"user:123": false, "user:456": false, "employee:123": true} {
+				key, err := store.Get(ctx, id)
+				if err != nil {
+					t.Fatalf("Error getting key: %v", err)
+				}
+
+				if (key != nil) != exists {
+					t.Errorf("Expected key %s to exist: %t, got %t", id, exists, key != nil)
+				}
+			}
+
+			for _, id := range []string{"user:123@2025-01", "user:456@2025-01", "user:123@2025-02"} {
+				if err := store.Create(ctx, id, []byte("key")); err != nil {
+					t.Fatalf("Error creating key: %v", err)
+				}
+			}
+
+			if err := DeleteSuffix(ctx, store, "@2025-01"); err != nil {
+				t.Fatalf("Error deleting keys by suffix: %v", err)
+			}
+
+			for id, exists := range map[string]bool{"user:123@2025-01": false, "user:456@2025-01": false, "user:123@2025-02": true, "employee:123": true} {
+				key, err := store.Get(ctx, id)
+				if err != nil {
+					t.Fatalf("Error getting key: %v", err)
+				}
+
+				if (key != nil) != exists {
+					t.Errorf("Expected key %s to exist: %t, got %t", id, exists, key != nil)
+				}
+			}
+		})
+	}
+}
+
+func TestFilePermissions(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := NewFile(dir)
+	if err != nil {
+		t.Fatalf("Error creating file key store: %v", err)
+	}
+
+	if err := store.Create(context.Background(), "user:123/../456", []byte("key")); err != nil {
+		t.Fatalf("Error creating key: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Error reading key directory: %v", err)
+	}
+
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 key file, got %d", len(entries))
+	}
+
+	info, err := os.Stat(filepath.Join(dir, entries[0].Name()))
+	if err != nil {
+		t.Fatalf("Error getting key file info: %v", err)
+	}
+
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("Expected key file permissions 0600, got %o", info.Mode().Perm())
+	}
+}
+
+func TestFileLongIDs(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	store, err := NewFile(dir)
+	if err != nil {
+		t.Fatalf("Error creating file key store: %v", err)
+	}
+
+	long := "user:" + strings.Repeat("a", 300)
+
+	for _, id := range []string{long + "@2025-01", long + "@2025-02", "employee:" + strings.Repeat("a", 300)} {
+		if err := store.Create(ctx, id, []byte("key")); err != nil {
+			t.Fatalf("Error creating key: %v", err)
+		}
+	}
+
+	if err := store.Create(ctx, long+"@2025-01", []byte("key2")); !errors.Is(err, ErrKeyExists) {
+		t.Errorf("Expected ErrKeyExists when creating existing key, got %v", err)
+	}
+
+	key, err := store.Get(ctx, long+"@2025-01")
+	if err != nil {
+		t.Fatalf("Error getting key: %v", err)
+	}
+
+	if !bytes.Equal(key, []byte("key")) {
+		t.Errorf("Expected key %q, got %q", "key", key)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Error reading key directory: %v", err)
+	}
+
+	for _, entry := range entries {
+		if len(entry.Name()) > 255 {
+			t.Errorf("Expected key file name of at most 255 bytes, got %d", len(entry.Name()))
+		}
+	}
+
+	if err := store.DeletePrefix(ctx, long+"@"); err != nil {
+		t.Fatalf("Error deleting keys by prefix: %v", err)
+	}
+
+	for id, exists := range map[string]bool{long + "@2025-01": false, long + "@2025-02": false, "employee:" + strings.Repeat("a", 300): true} {
+		key, err := store.Get(ctx, id)
+		if err != nil {
+			t.Fatalf("Error getting key: %v", err)
+		}
+
+		if (key != nil) != exists {
+			t.Errorf("Expected key %s to exist: %t, got %t", id, exists, key != nil)
+		}
+	}
+}
+
+func TestFileCaseInsensitiveNames(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	store, err := NewFile(dir)
+	if err != nil {
+		t.Fatalf("Error creating file key store: %v", err)
+	}
+
+	// These ids would share a key file on case-insensitive file systems if their names were case-sensitive
+	for _, id := range []string{"u @", "u Z"} {
+		if err := store.Create(ctx, id, []byte(id)); err != nil {
+			t.Fatalf("Error creating key: %v", err)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Error reading key directory: %v", err)
+	}
+
+	names := map[string]bool{}
+
+	for _, entry := range entries {
+		names[strings.ToLower(entry.Name())] = true
+	}
+
+	if len(names) != 2 {
+		t.Errorf("Expected 2 key file names that differ ignoring case, got %d", len(names))
+	}
+
+	for _, id := range []string{"u @", "u Z"} {
+		key, err := store.Get(ctx, id)
+		if err != nil {
+			t.Fatalf("Error getting key: %v", err)
+		}
+
+		if !bytes.Equal(key, []byte(id)) {
+			t.Errorf("Expected key %q, got %q", id, key)
+		}
+	}
+}
+
+// racingKeyStore simulates another writer creating the key between the first Get and Create of GetOrCreate.
+type racingKeyStore struct {
+	KeyStore
+	raced bool
+}
+
+func (s *racingKeyStore) Get(ctx context.Context, id string) ([]byte, error) {
+	if !s.raced {
+		s.raced = true
+
+		if err := s.KeyStore.Create(ctx, id, []byte("theirs")); err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+
+	return s.KeyStore.Get(ctx, id)
+}
+
+func TestGetOrCreate(t *testing.T) {
+	ctx := context.Background()
+	newKey := func() ([]byte, error) {
+		return []byte("ours"), nil
+	}
+
+	for _, tt := range []struct {
+		explanation string
+		store       KeyStore
+		expected    []byte
+	}{
+		{
+			explanation: "Missing key",
+			store:       NewMemory(),
+			expected:    []byte("ours"),
+		},
+		{
+			explanation: "Key created concurrently",
+			store:       &racingKeyStore{KeyStore: NewMemory()},
+			expected:    []byte("theirs"),
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			key, err := GetOrCreate(ctx, tt.store, "user:123", newKey)
+			if err != nil {
+				t.Fatalf("Error getting or creating key: %v", err)
+			}
+
+			if !bytes.Equal(key, tt.expected) {
+				t.Errorf("Expected key %q, got %q", tt.expected, key)
+			}
+
+			// The existing key is returned without creating a new one
+			key, err = GetOrCreate(ctx, tt.store, "user:123", func() ([]byte, error) {
+				return nil, errors.New("unexpected key creation")
+			})
+			if err != nil {
+				t.Fatalf("Error getting existing key: %v", err)
+			}
+
+			if !bytes.Equal(key, tt.expected) {
+				t.Errorf("Expected key %q, got %q", tt.expected, key)
+			}
+		})
+	}
+}
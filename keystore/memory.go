@@ -0,0 +1,82 @@
+package keystore
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+)
+
+// Memory is a KeyStore that keeps keys in memory. Keys are lost when the process exits, so it is only suitable for
+// tests and ephemeral data.
+type Memory struct {
+	mu   sync.RWMutex
+	keys map[string][]byte
+}
+
+// NewMemory returns an empty in-memory KeyStore.
+func NewMemory() *Memory {
+	return &Memory{
+		keys: make(map[string][]byte),
+	}
+}
+
+func (m *Memory) Get(_ context.Context, id string) ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	key, ok := m.keys[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return bytes.Clone(key), nil
+}
+
+func (m *Memory) Create(_ context.Context, id string, key []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.keys[id]; ok {
+		return ErrKeyExists
+	}
+
+	m.keys[id] = bytes.Clone(key)
+
+	return nil
+}
+
+func (m *Memory) Delete(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.keys, id)
+
+	return nil
+}
+
+func (m *Memory) DeletePrefix(_ context.Context, prefix string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id := range m.keys {
+		if strings.HasPrefix(id, prefix) {
+			delete(m.keys, id)
+		}
+	}
+
+	return nil
+}
+
+func (m *Memory) DeleteSuffix(_ context.Context, suffix string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id := range m.keys {
+		if strings.HasSuffix(id, suffix) {
+			delete(m.keys, id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package protoprivacy
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+)
+
+const defaultMaskCharacter = "*"
+
+// errMaskHashSaltNotSet is returned when masking a value using the hash strategy without a salt, as unsalted hashes of
+// personal data can be recovered by hashing guessed values.
+var errMaskHashSaltNotSet = errors.New("hash mask requires a salt set using WithMaskHashSalt")
+
+// maskRequiresSalt reports whether the mask uses the hash strategy but no salt was set, so it cannot be applied.
+func (p *Privacy) maskRequiresSalt(mask *privacy.PrivacyFieldOptions_Mask) bool {
+	return mask.WhichStrategy() == privacy.PrivacyFieldOptions_Mask_Hash_case && len(p.maskHashSalt) == 0
+}
+
+// applyMask masks value using the strategy configured in mask. Empty values are returned unchanged. Values that are not
+// longer than the number of characters kept by keep_last or keep_first are masked completely.
+func applyMask(mask *privacy.PrivacyFieldOptions_Mask, value string, hashSalt []byte) (string, error) {
+	if value == "" {
+		return value, nil
+	}
+
+	character := defaultMaskCharacter
+	if mask.HasCharacter() {
+		character = mask.GetCharacter()
+	}
+
+	runes := []rune(value)
+
+	switch mask.WhichStrategy() {
+	case privacy.PrivacyFieldOptions_Mask_KeepLast_case:
+		keep := int(mask.GetKeepLast())
+		if keep >= len(runes) {
+			return strings.Repeat(character, len(runes)), nil
+		}
+		return strings.Repeat(character, len(runes)-keep) + string(runes[len(runes)-keep:]), nil
+	case privacy.PrivacyFieldOptions_Mask_KeepFirst_case:
+		keep := int(mask.GetKeepFirst())
+		if keep >= len(runes) {
+			return strings.Repeat(character, len(runes)), nil
+		}
+		return string(runes[:keep]) + strings.Repeat(character, len(runes)-keep), nil
+	case privacy.PrivacyFieldOptions_Mask_EmailDomain_case:
+		at := strings.LastIndex(value, "@")
+		if at < 0 {
+			return strings.Repeat(character, len(runes)), nil
+		}
+		return strings.Repeat(character, len([]rune(value[:at]))) + value[at:], nil
+	case privacy.PrivacyFieldOptions_Mask_FixedLength_case:
+		return strings.Repeat(character, int(mask.GetFixedLength())), nil
+	case privacy.PrivacyFieldOptions_Mask_Hash_case:
+		if len(hashSalt) == 0 {
+			return "", errMaskHashSaltNotSet
+		}
+		h := hmac.New(sha256.New, hashSalt)
+		h.Write([]byte(value))
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,189 @@
+package protoprivacy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+)
+
+// MigratingOption configures a MigratingCrypter.
+type MigratingOption func(*MigratingCrypter)
+
+// WithMigrationHeader detects the format of ciphertexts by their first byte instead of trial decryption: ciphertexts
+// starting with header are decrypted by the current crypter and all other ciphertexts by the legacy crypter. The
+// current crypter must produce ciphertexts starting with header, for example a format version byte, and the legacy
+// crypter must never do so. The ciphertexts are not modified, so the MigratingCrypter can be replaced by the current
+// crypter once the migration is complete.
+func WithMigrationHeader(header byte) MigratingOption {
+	return func(m *MigratingCrypter) {
+		m.header = []byte{header}
+	}
+}
+
+// MigratingCrypter is a Crypter for migrating from a legacy crypter to a current crypter, for example a different
+// implementation or key management service. It always encrypts using the current crypter and decrypts using the
+// crypter that produced the ciphertext, so data encrypted by both crypters can coexist during the migration.
+//
+// When data is decrypted by the legacy crypter, DecryptWithReport sets DecryptReport.ReencryptRecommended, so that
+// callers can lazily move envelopes to the current crypter using Privacy.Reencrypt when they are read.
+type MigratingCrypter struct {
+	current Crypter
+	legacy  Crypter
+	header  []byte
+}
+
+// NewMigratingCrypter returns a MigratingCrypter that encrypts using current and decrypts using current or legacy. By
+// default, the format of a ciphertext is detected by trying to decrypt it using current and then using legacy. Use
+// WithMigrationHeader to detect it without trial decryption.
+func NewMigratingCrypter(current Crypter, legacy Crypter, opts ...MigratingOption) *MigratingCrypter {
+	m := &MigratingCrypter{
+		current: current,
+		legacy:  legacy,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+func (m *MigratingCrypter) Encrypt(ctx context.Context, dataSubjectID string, cleartext []byte) ([]byte, error) {
+	return m.current.Encrypt(ctx, dataSubjectID, cleartext)
+}
+
+func (m *MigratingCrypter) Decrypt(ctx context.Context, dataSubjectID string, ciphertext []byte) ([]byte, error) {
+	if m.header != nil {
+		if bytes.HasPrefix(ciphertext, m.header) {
+			return m.current.Decrypt(ctx, dataSubjectID, ciphertext)
+		}
+
+		return m.decryptLegacy(ctx, dataSubjectID, ciphertext)
+	}
+
+	cleartext, err := m.current.Decrypt(ctx, dataSubjectID, ciphertext)
+	if err == nil && cleartext != nil {
+		return cleartext, nil
+	}
+
+	if ctx.Err() != nil {
+		return nil, err
+	}
+
+	legacyCleartext, legacyErr := m.decryptLegacy(ctx, dataSubjectID, ciphertext)
+	if legacyErr == nil && legacyCleartext != nil {
+		return legacyCleartext, nil
+	}
+
+	// Neither crypter could decrypt the ciphertext, so it is unknown which crypter produced it. Prefer reporting the
+	// data as shredded, as a crypter that did not produce the ciphertext is expected to report it as corrupt.
+	if !keyUnavailable(cleartext, err) && keyUnavailable(legacyCleartext, legacyErr) {
+		return legacyCleartext, legacyErr
+	}
+
+	return cleartext, err
+}
+
+// Shred shreds the data subject id using both crypters, which must implement Shredder.
+func (m *MigratingCrypter) Shred(ctx context.Context, dataSubjectID string) error {
+	return m.shred(func(shredder Shredder) error {
+		return shredder.Shred(ctx, dataSubjectID)
+	})
+}
+
+// ShredPrefix shreds the data subject ids starting with the prefix using both crypters, which must implement Shredder.
+func (m *MigratingCrypter) ShredPrefix(ctx context.Context, prefix string) error {
+	return m.shred(func(shredder Shredder) error {
+		return shredder.ShredPrefix(ctx, prefix)
+	})
+}
+
+// ShredSuffix shreds the data subject ids ending with the suffix using both crypters, which must implement
+// SuffixShredder.
+func (m *MigratingCrypter) ShredSuffix(ctx context.Context, suffix string) error {
+	crypters := []struct {
+		name    string
+		crypter Crypter
+	}{
+		{name: "current", crypter: m.current},
+		{name: "legacy", crypter: m.legacy},
+	}
+
+	for _, c := range crypters {
+		shredder, ok := c.crypter.(SuffixShredder)
+		if !ok {
+			return fmt.Errorf("%s crypter does not implement SuffixShredder", c.name)
+		}
+
+		if err := shredder.ShredSuffix(ctx, suffix); err != nil {
+			return fmt.Errorf("error shredding using %s crypter: %w", c.name, err)
+		}
+	}
+
+	return nil
+}
+
+// IsShredded reports whether the data subject id is shredded in both crypters, which must implement Shredder.
+func (m *MigratingCrypter) IsShredded(ctx context.Context, dataSubjectID string) (bool, error) {
+	shredded := true
+
+	err := m.shred(func(shredder Shredder) error {
+		s, err := shredder.IsShredded(ctx, dataSubjectID)
+		shredded = shredded && s
+		return err
+	})
+
+	return shredded, err
+}
+
+func (m *MigratingCrypter) decryptLegacy(ctx context.Context, dataSubjectID string, ciphertext []byte) ([]byte, error) {
+	cleartext, err := m.legacy.Decrypt(ctx, dataSubjectID, ciphertext)
+	if err == nil && cleartext != nil {
+		signalReencrypt(ctx)
+	}
+
+	return cleartext, err
+}
+
+// keyUnavailable reports whether a crypter signaled that the key of the data subject is shredded or cannot be found.
+func keyUnavailable(cleartext []byte, err error) bool {
+	outcome, _ := decryptOutcome(cleartext, err)
+	return outcome == DecryptOutcomeKeyShredded || outcome == DecryptOutcomeKeyNotFound
+}
+
+func (m *MigratingCrypter) shred(fn func(shredder Shredder) error) error {
+	crypters := []struct {
+		name    string
+		crypter Crypter
+	}{
+		{name: "current", crypter: m.current},
+		{name: "legacy", crypter: m.legacy},
+	}
+
+	for _, c := range crypters {
+		shredder, ok := c.crypter.(Shredder)
+		if !ok {
+			return fmt.Errorf("%s crypter does not implement Shredder", c.name)
+		}
+
+		if err := fn(shredder); err != nil {
+			return fmt.Errorf("error shredding using %s crypter: %w", c.name, err)
+		}
+	}
+
+	return nil
+}
+
+type reencryptSignalKey struct{}
+
+// withReencryptSignal returns a context that lets crypters recommend re-encrypting the data being decrypted by setting
+// ReencryptRecommended on the report.
+func withReencryptSignal(ctx context.Context, report *DecryptReport) context.Context {
+	return context.WithValue(ctx, reencryptSignalKey{}, report)
+}
+
+func signalReencrypt(ctx context.Context) {
+	if report, ok := ctx.Value(reencryptSignalKey{}).(*DecryptReport); ok {
+		report.ReencryptRecommended = true
+	}
+}
@@ -0,0 +1,207 @@
+package protoprivacy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+)
+
+// headerCrypter prefixes ciphertexts with a header byte and rejects ciphertexts without it as corrupt.
+type headerCrypter struct {
+	header   byte
+	shredded bool
+	decrypts int
+}
+
+func (c *headerCrypter) Encrypt(_ context.Context, _ string, cleartext []byte) ([]byte, error) {
+	return append([]byte{c.header}, cleartext...), nil
+}
+
+func (c *headerCrypter) Decrypt(_ context.Context, _ string, ciphertext []byte) ([]byte, error) {
+	c.decrypts++
+
+	if c.shredded {
+		return nil, ErrKeyShredded
+	}
+
+	if !bytes.HasPrefix(ciphertext, []byte{c.header}) {
+		return nil, ErrCiphertextCorrupt
+	}
+
+	return append([]byte{}, ciphertext[1:]...), nil
+}
+
+func TestMigratingCrypter(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		explanation     string
+		opts            []MigratingOption
+		currentDecrypts int
+	}{
+		{
+			explanation:     "Trial decryption",
+			currentDecrypts: 2,
+		},
+		{
+			explanation:     "Header",
+			opts:            []MigratingOption{WithMigrationHeader('c')},
+			currentDecrypts: 1,
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			current := &headerCrypter{header: 'c'}
+			legacy := &headerCrypter{header: 'l'}
+			m := NewMigratingCrypter(current, legacy, tt.opts...)
+
+			ciphertext, err := m.Encrypt(ctx, "123", []byte("test"))
+			if err != nil {
+				t.Fatalf("Error encrypting: %v", err)
+			}
+
+			if ciphertext[0] != 'c' {
+				t.Errorf("Expected ciphertext to be encrypted using the current crypter, got %q", ciphertext)
+			}
+
+			legacyCiphertext, err := legacy.Encrypt(ctx, "123", []byte("legacy"))
+			if err != nil {
+				t.Fatalf("Error encrypting: %v", err)
+			}
+
+			for _, c := range []struct {
+				ciphertext []byte
+				expected   string
+			}{
+				{ciphertext: ciphertext, expected: "test"},
+				{ciphertext: legacyCiphertext, expected: "legacy"},
+			} {
+				cleartext, err := m.Decrypt(ctx, "123", c.ciphertext)
+				if err != nil {
+					t.Fatalf("Error decrypting: %v", err)
+				}
+
+				if string(cleartext) != c.expected {
+					t.Errorf("Expected cleartext %q, got %q", c.expected, cleartext)
+				}
+			}
+
+			if current.decrypts != tt.currentDecrypts {
+				t.Errorf("Expected %d decryptions using the current crypter, got %d", tt.currentDecrypts, current.decrypts)
+			}
+
+			if legacy.decrypts != 1 {
+				t.Errorf("Expected 1 decryption using the legacy crypter, got %d", legacy.decrypts)
+			}
+		})
+	}
+}
+
+func TestMigratingCrypterShreddedLegacy(t *testing.T) {
+	m := NewMigratingCrypter(&headerCrypter{header: 'c'}, &headerCrypter{header: 'l', shredded: true})
+
+	// The current crypter reports the legacy ciphertext as corrupt, but the legacy crypter reports it as shredded
+	if _, err := m.Decrypt(context.Background(), "123", []byte("ltest")); !errors.Is(err, ErrKeyShredded) {
+		t.Errorf("Expected ErrKeyShredded, got %v", err)
+	}
+
+	m = NewMigratingCrypter(&headerCrypter{header: 'c'}, &headerCrypter{header: 'l'})
+
+	if _, err := m.Decrypt(context.Background(), "123", []byte("xtest")); !errors.Is(err, ErrCiphertextCorrupt) {
+		t.Errorf("Expected ErrCiphertextCorrupt, got %v", err)
+	}
+}
+
+func TestMigratingCrypterReencrypt(t *testing.T) {
+	ctx := context.Background()
+	current := &headerCrypter{header: 'c'}
+	legacy := &headerCrypter{header: 'l'}
+
+	msg := testprotos.TestMessage_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("test"),
+	}.Build()
+
+	encrypted, err := New(legacy).Encrypt(ctx, msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	p := New(NewMigratingCrypter(current, legacy))
+
+	decrypted, report, err := p.DecryptWithReport(ctx, encrypted)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if !proto.Equal(decrypted, msg) {
+		t.Errorf("Decrypted message does not match original message, expected %v, got %v", msg, decrypted)
+	}
+
+	if !report.ReencryptRecommended {
+		t.Error("Expected re-encryption to be recommended for data decrypted using the legacy crypter")
+	}
+
+	reencrypted, err := p.Reencrypt(ctx, encrypted)
+	if err != nil {
+		t.Fatalf("Error re-encrypting message: %v", err)
+	}
+
+	decrypted, report, err = p.DecryptWithReport(ctx, reencrypted)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if !proto.Equal(decrypted, msg) {
+		t.Errorf("Decrypted message does not match original message, expected %v, got %v", msg, decrypted)
+	}
+
+	if report.ReencryptRecommended {
+		t.Error("Expected re-encryption not to be recommended for data decrypted using the current crypter")
+	}
+}
+
+func TestMigratingCrypterShred(t *testing.T) {
+	ctx := context.Background()
+	current := &fakeShredderCrypter{}
+	legacy := &fakeShredderCrypter{}
+
+	m := NewMigratingCrypter(current, legacy)
+
+	if err := m.Shred(ctx, "123"); err != nil {
+		t.Fatalf("Error shredding data subject: %v", err)
+	}
+
+	if !slices.Equal(current.shredded, []string{"123"}) || !slices.Equal(legacy.shredded, []string{"123"}) {
+		t.Errorf("Expected data subject to be shredded using both crypters, got %v and %v", current.shredded, legacy.shredded)
+	}
+
+	shredded, err := m.IsShredded(ctx, "123")
+	if err != nil {
+		t.Fatalf("Error checking if data subject is shredded: %v", err)
+	}
+
+	if !shredded {
+		t.Error("Expected data subject to be shredded")
+	}
+
+	if err := m.ShredSuffix(ctx, "@2025-02"); err != nil {
+		t.Fatalf("Error shredding suffix: %v", err)
+	}
+
+	if !slices.Equal(current.suffixes, []string{"@2025-02"}) || !slices.Equal(legacy.suffixes, []string{"@2025-02"}) {
+		t.Errorf("Expected suffix to be shredded using both crypters, got %v and %v", current.suffixes, legacy.suffixes)
+	}
+
+	if err := NewMigratingCrypter(current, fakeCrypter{}).Shred(ctx, "123"); err == nil {
+		t.Error("Expected error shredding with crypter that does not implement Shredder")
+	}
+
+	if err := NewMigratingCrypter(current, fakeCrypter{}).ShredSuffix(ctx, "@2025-02"); err == nil {
+		t.Error("Expected error shredding suffix with crypter that does not implement SuffixShredder")
+	}
+}
@@ -0,0 +1,97 @@
+package protoprivacy
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// ShredEvent describes data subjects that have been shredded. If IsPrefix is true, Prefix is set, if IsSuffix is true,
+// Suffix is set, otherwise DataSubjectID is set.
+type ShredEvent struct {
+	// DataSubjectID is the data subject id that was shredded.
+	DataSubjectID string
+
+	// Prefix is the prefix of the data subject ids that were shredded if IsPrefix is true. An empty prefix means all
+	// data subject ids were shredded.
+	Prefix string
+
+	// IsPrefix reports whether all data subject ids starting with Prefix were shredded.
+	IsPrefix bool
+
+	// Suffix is the suffix of the data subject ids that were shredded if IsSuffix is true, such as the suffix of a key
+	// bucket shredded by Privacy.ShredBucket.
+	Suffix string
+
+	// IsSuffix reports whether all data subject ids ending with Suffix were shredded.
+	IsSuffix bool
+}
+
+// Matches reports whether the data subject id was shredded by the event.
+func (e ShredEvent) Matches(dataSubjectID string) bool {
+	switch {
+	case e.IsPrefix:
+		return strings.HasPrefix(dataSubjectID, e.Prefix)
+	case e.IsSuffix:
+		return strings.HasSuffix(dataSubjectID, e.Suffix)
+	}
+
+	return dataSubjectID == e.DataSubjectID
+}
+
+// ShredNotifier publishes shred events to subscribers, so that caches of keys, possibly in other processes, can evict
+// shredded data subjects. Implement it using your message bus to notify other replicas of a service.
+type ShredNotifier interface {
+	// Publish publishes the event to all subscribers.
+	Publish(ctx context.Context, event ShredEvent) error
+
+	// Subscribe registers a handler that is called for every published event. The returned function unsubscribes the
+	// handler.
+	Subscribe(handler func(event ShredEvent)) (unsubscribe func())
+}
+
+// LocalShredNotifier is a ShredNotifier that delivers events to subscribers in the same process. Handlers are called
+// synchronously by Publish.
+type LocalShredNotifier struct {
+	mu       sync.RWMutex
+	handlers map[int]func(event ShredEvent)
+	nextID   int
+}
+
+// NewLocalShredNotifier returns a LocalShredNotifier without subscribers.
+func NewLocalShredNotifier() *LocalShredNotifier {
+	return &LocalShredNotifier{
+		handlers: make(map[int]func(event ShredEvent)),
+	}
+}
+
+func (n *LocalShredNotifier) Publish(_ context.Context, event ShredEvent) error {
+	n.mu.RLock()
+	handlers := make([]func(event ShredEvent), 0, len(n.handlers))
+	for _, handler := range n.handlers {
+		handlers = append(handlers, handler)
+	}
+	n.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+
+	return nil
+}
+
+func (n *LocalShredNotifier) Subscribe(handler func(event ShredEvent)) func() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	id := n.nextID
+	n.nextID++
+	n.handlers[id] = handler
+
+	return func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+
+		delete(n.handlers, id)
+	}
+}
@@ -0,0 +1,99 @@
+package protoprivacy
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestLocalShredNotifier(t *testing.T) {
+	n := NewLocalShredNotifier()
+
+	var first, second []ShredEvent
+
+	unsubscribeFirst := n.Subscribe(func(event ShredEvent) {
+		first = append(first, event)
+	})
+
+	n.Subscribe(func(event ShredEvent) {
+		second = append(second, event)
+	})
+
+	if err := n.Publish(context.Background(), ShredEvent{DataSubjectID: "user:123"}); err != nil {
+		t.Fatalf("Error publishing event: %v", err)
+	}
+
+	unsubscribeFirst()
+
+	if err := n.Publish(context.Background(), ShredEvent{Prefix: "user:", IsPrefix: true}); err != nil {
+		t.Fatalf("Error publishing event: %v", err)
+	}
+
+	if expected := []ShredEvent{{DataSubjectID: "user:123"}}; !slices.Equal(first, expected) {
+		t.Errorf("Expected unsubscribed handler to receive %v, got %v", expected, first)
+	}
+
+	if expected := []ShredEvent{{DataSubjectID: "user:123"}, {Prefix: "user:", IsPrefix: true}}; !slices.Equal(second, expected) {
+		t.Errorf("Expected handler to receive %v, got %v", expected, second)
+	}
+}
+
+func TestShredEventMatches(t *testing.T) {
+	for _, tt := range []struct {
+		explanation   string
+		event         ShredEvent
+		dataSubjectID string
+		expected      bool
+	}{
+		{
+			explanation:   "Same data subject id",
+			event:         ShredEvent{DataSubjectID: "user:123"},
+			dataSubjectID: "user:123",
+			expected:      true,
+		},
+		{
+			explanation:   "Other data subject id",
+			event:         ShredEvent{DataSubjectID: "user:123"},
+			dataSubjectID: "user:1234",
+		},
+		{
+			explanation:   "Matching prefix",
+			event:         ShredEvent{Prefix: "user:123@", IsPrefix: true},
+			dataSubjectID: "user:123@2025-01",
+			expected:      true,
+		},
+		{
+			explanation:   "Other prefix",
+			event:         ShredEvent{Prefix: "user:", IsPrefix: true},
+			dataSubjectID: "employee:123",
+		},
+		{
+			explanation:   "Empty prefix",
+			event:         ShredEvent{IsPrefix: true},
+			dataSubjectID: "user:123",
+			expected:      true,
+		},
+		{
+			explanation:   "Matching suffix",
+			event:         ShredEvent{Suffix: "@2025-01", IsSuffix: true},
+			dataSubjectID: "user:123@2025-01",
+			expected:      true,
+		},
+		{
+			explanation:   "Other suffix",
+			event:         ShredEvent{Suffix: "@2025-01", IsSuffix: true},
+			dataSubjectID: "user:123@2025-02",
+		},
+		{
+			explanation:   "Empty data subject id",
+			event:         ShredEvent{},
+			dataSubjectID: "user:123",
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			if matches := tt.event.Matches(tt.dataSubjectID); matches != tt.expected {
+				t.Errorf("Expected %t, got %t", tt.expected, matches)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package protoprivacy
+
+import "time"
+
+// Option configures a Privacy instance created using New.
+type Option func(*Privacy)
+
+// WithRedactionMarker sets the value written to string and bytes personal data fields without a mask by Redact. By
+// default, personal data fields are cleared.
+func WithRedactionMarker(marker string) Option {
+	return func(p *Privacy) {
+		p.redactionMarker = marker
+	}
+}
+
+// WithMaskHashSalt sets the salt used by the hash mask strategy. The salt should be kept secret, otherwise hashed
+// values can be recovered by hashing guessed values.
+func WithMaskHashSalt(salt []byte) Option {
+	return func(p *Privacy) {
+		p.maskHashSalt = salt
+	}
+}
+
+// WithTokenKeyProvider sets the provider of keys used to generate tokens for pseudonymized personal data fields. If no
+// provider is set, pseudonymized fields are cleared.
+func WithTokenKeyProvider(provider TokenKeyProvider) Option {
+	return func(p *Privacy) {
+		p.tokenKeyProvider = provider
+	}
+}
+
+// WithBlindIndexKey sets the key used to compute blind indexes. The key must be kept secret and must not change,
+// otherwise existing blind indexes can no longer be looked up.
+func WithBlindIndexKey(key []byte) Option {
+	return func(p *Privacy) {
+		p.blindIndexKey = key
+	}
+}
+
+// WithPolicy sets the policy consulted by Decrypt to decide whether a caller may decrypt personal data fields. By
+// default, or if policy is nil, AllowAllPolicy is used.
+func WithPolicy(policy Policy) Option {
+	return func(p *Privacy) {
+		if policy == nil {
+			policy = AllowAllPolicy
+		}
+
+		p.policy = policy
+	}
+}
+
+// WithClock sets the function used to get the current time when checking whether the retention period of personal data
+// fields has expired. By default, or if clock is nil, time.Now is used.
+func WithClock(clock func() time.Time) Option {
+	return func(p *Privacy) {
+		if clock == nil {
+			clock = time.Now
+		}
+
+		p.clock = clock
+	}
+}
@@ -0,0 +1,96 @@
+package protoprivacy
+
+import (
+	"context"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// PersonalDataField describes a personal data field being decrypted.
+type PersonalDataField struct {
+	Descriptor protoreflect.FieldDescriptor
+	Category   string
+}
+
+// Policy decides whether a caller may decrypt personal data fields. Fields the policy denies are cleared or set to
+// their fallback values by Decrypt, even if the data subject's key is available.
+type Policy interface {
+	// Allow reports whether a caller with the purpose may decrypt the personal data field in the message. The purpose
+	// is set using WithPurpose and is empty if the context does not contain a purpose.
+	Allow(ctx context.Context, purpose string, message protoreflect.MessageDescriptor, field PersonalDataField) bool
+}
+
+// AllowAllPolicy allows all personal data fields to be decrypted. It is the default policy.
+var AllowAllPolicy Policy = allowAllPolicy{}
+
+type allowAllPolicy struct{}
+
+func (allowAllPolicy) Allow(context.Context, string, protoreflect.MessageDescriptor, PersonalDataField) bool {
+	return true
+}
+
+// PolicyRule allows callers with a purpose to decrypt personal data fields. Empty values match any purpose, message,
+// field or category.
+type PolicyRule struct {
+	Purpose  string
+	Message  protoreflect.FullName
+	Field    protoreflect.FullName
+	Category string
+}
+
+func (r PolicyRule) matches(purpose string, message protoreflect.MessageDescriptor, field PersonalDataField) bool {
+	return (r.Purpose == "" || r.Purpose == purpose) &&
+		(r.Message == "" || r.Message == message.FullName()) &&
+		(r.Field == "" || r.Field == field.Descriptor.FullName()) &&
+		(r.Category == "" || r.Category == field.Category)
+}
+
+// TablePolicy is a declarative policy that allows a personal data field to be decrypted if it matches at least one of
+// its rules. Fields that do not match any rule are denied.
+type TablePolicy []PolicyRule
+
+func (t TablePolicy) Allow(_ context.Context, purpose string, message protoreflect.MessageDescriptor, field PersonalDataField) bool {
+	for _, rule := range t {
+		if rule.matches(purpose, message, field) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type purposeKey struct{}
+
+// WithPurpose returns a context carrying the purpose for which the caller decrypts messages. The purpose is passed to
+// the Policy consulted by Decrypt.
+func WithPurpose(ctx context.Context, purpose string) context.Context {
+	return context.WithValue(ctx, purposeKey{}, purpose)
+}
+
+// PurposeFromContext returns the purpose set using WithPurpose or an empty string if the context does not contain a
+// purpose.
+func PurposeFromContext(ctx context.Context) string {
+	purpose, _ := ctx.Value(purposeKey{}).(string)
+	return purpose
+}
+
+// unauthorizedFieldFilter returns a filter selecting the personal data fields in the message the caller is not
+// authorized to decrypt by their categories or the policy, or nil if the caller is authorized to decrypt all fields.
+func (p *Privacy) unauthorizedFieldFilter(ctx context.Context, message protoreflect.MessageDescriptor) fieldFilter {
+	categoryFilter := unauthorizedCategoryFilter(ctx)
+
+	if _, ok := p.policy.(allowAllPolicy); ok {
+		return categoryFilter
+	}
+
+	purpose := PurposeFromContext(ctx)
+
+	return func(fd protoreflect.FieldDescriptor, personalData *privacy.PrivacyFieldOptions_PersonalData) bool {
+		if categoryFilter != nil && categoryFilter(fd, personalData) {
+			return true
+		}
+
+		return !p.policy.Allow(ctx, purpose, message, PersonalDataField{Descriptor: fd, Category: personalDataCategory(personalData)})
+	}
+}
@@ -0,0 +1,105 @@
+package protoprivacy
+
+import (
+	"context"
+	"testing"
+
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDecryptWithPolicy(t *testing.T) {
+	msg := testprotos.TestCategories_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("john@example.com"),
+		Data2: proto.String("diagnosis"),
+		Data3: proto.String("newsletter"),
+		Data4: proto.String("uncategorized"),
+	}.Build()
+
+	policy := TablePolicy{
+		{Purpose: "marketing", Category: CategoryContact},
+		{Purpose: "marketing", Field: "boostport.privacy.testing.TestCategories.data3"},
+		{Purpose: "fraud"},
+		{Message: "boostport.privacy.testing.TestMessage"},
+	}
+
+	for _, tt := range []struct {
+		explanation string
+		ctx         context.Context
+		expected    proto.Message
+	}{
+		{
+			explanation: "Purpose with category and field rules",
+			ctx:         WithPurpose(context.Background(), "marketing"),
+			expected: testprotos.TestCategories_builder{
+				Id:    proto.String("123"),
+				Data1: proto.String("john@example.com"),
+				Data2: proto.String("hidden"),
+				Data3: proto.String("newsletter"),
+			}.Build(),
+		},
+		{
+			explanation: "Purpose allowed everything",
+			ctx:         WithPurpose(context.Background(), "fraud"),
+			expected:    msg,
+		},
+		{
+			explanation: "Purpose allowed everything with restricted categories",
+			ctx:         WithAuthorizedCategories(WithPurpose(context.Background(), "fraud"), CategoryHealth),
+			expected: testprotos.TestCategories_builder{
+				Id:    proto.String("123"),
+				Data2: proto.String("diagnosis"),
+			}.Build(),
+		},
+		{
+			explanation: "No purpose",
+			ctx:         context.Background(),
+			expected: testprotos.TestCategories_builder{
+				Id:    proto.String("123"),
+				Data2: proto.String("hidden"),
+			}.Build(),
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			p := New(fakeCrypter{}, WithPolicy(policy))
+
+			envelope, err := p.Encrypt(context.Background(), msg)
+			if err != nil {
+				t.Fatalf("Error encrypting message: %v", err)
+			}
+
+			decrypted, err := p.Decrypt(tt.ctx, envelope)
+			if err != nil {
+				t.Fatalf("Error decrypting message: %v", err)
+			}
+
+			if !proto.Equal(tt.expected, decrypted) {
+				t.Errorf("Decrypted message does not match expected message: %v", decrypted)
+			}
+		})
+	}
+}
+
+func TestDecryptWithNilPolicy(t *testing.T) {
+	msg := testprotos.TestCategories_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("john@example.com"),
+	}.Build()
+
+	p := New(fakeCrypter{}, WithPolicy(nil))
+
+	envelope, err := p.Encrypt(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	decrypted, err := p.Decrypt(context.Background(), envelope)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if !proto.Equal(msg, decrypted) {
+		t.Errorf("Decrypted message does not match original message, expected %v, got %v", msg, decrypted)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
 	"google.golang.org/protobuf/proto"
@@ -16,14 +17,33 @@ import (
 )
 
 type Privacy struct {
-	mu      sync.Mutex
-	cache   atomic.Pointer[messageCache]
-	crypter Crypter
+	mu               sync.Mutex
+	cache            atomic.Pointer[messageCache]
+	crypter          Crypter
+	redactionMarker  string
+	maskHashSalt     []byte
+	tokenKeyProvider TokenKeyProvider
+	blindIndexKey    []byte
+	policy           Policy
+	clock            func() time.Time
+	errorPolicy      ErrorPolicy
+
+	compressor           Compressor
+	compressionThreshold int
+	decompressors        map[string]Compressor
+	maxDecompressedSize  int64
+
+	// configErr is the error of invalid options, which is returned by the methods that process messages
+	configErr error
 }
 
-func (p *Privacy) loadMessage(m proto.Message) (bool, error) {
+func (p *Privacy) loadMessage(m proto.Message) (*message, error) {
+	if p.configErr != nil {
+		return nil, fmt.Errorf("invalid options: %w", p.configErr)
+	}
+
 	if validatedMessage, ok := (*p.cache.Load())[m.ProtoReflect().Descriptor()]; ok {
-		return validatedMessage.hasPrivacyFields, validatedMessage.err
+		return validatedMessage, validatedMessage.err
 	}
 
 	p.mu.Lock()
@@ -32,31 +52,43 @@ func (p *Privacy) loadMessage(m proto.Message) (bool, error) {
 	cache := *p.cache.Load()
 
 	if validatedMessage, ok := cache[m.ProtoReflect().Descriptor()]; ok {
-		return validatedMessage.hasPrivacyFields, validatedMessage.err
+		return validatedMessage, validatedMessage.err
 	}
 
 	cloned := cache.Clone()
-	hasPrivacyFields, validatedMessageErr := validateMessage(m)
-	cloned[m.ProtoReflect().Descriptor()] = &message{hasPrivacyFields: hasPrivacyFields, err: validatedMessageErr}
+	hasPrivacyFields, compiledConditions, validatedMessageErr := validateMessage(m)
+	validatedMessage := &message{hasPrivacyFields: hasPrivacyFields, err: validatedMessageErr}
+
+	if hasPrivacyFields && validatedMessageErr == nil {
+		validatedMessage.conditions = compiledConditions
+		validatedMessage.retention = loadRetention(m.ProtoReflect().Descriptor())
+	}
+
+	cloned[m.ProtoReflect().Descriptor()] = validatedMessage
 
 	p.cache.Store(&cloned)
 
-	return hasPrivacyFields, validatedMessageErr
+	return validatedMessage, validatedMessage.err
 }
 
 func (p *Privacy) Encrypt(ctx context.Context, message proto.Message) (proto.Message, error) {
-	hasPrivacyFields, err := p.loadMessage(message)
+	loaded, err := p.loadMessage(message)
 
 	if err != nil {
 		return nil, err
 	}
 
-	if !hasPrivacyFields {
+	if !loaded.hasPrivacyFields {
 		return message, nil
 	}
 
+	if err := loaded.retention.checkTimestamp(message.ProtoReflect()); err != nil {
+		return nil, err
+	}
+
 	withoutPersonalData := proto.Clone(message)
-	dataSubjectID, err := maskPersonalDataFieldsAndGetDataSubjectID(withoutPersonalData.ProtoReflect())
+	blindIndexes := make(map[string]string)
+	dataSubjectID, err := p.maskPersonalDataFieldsAndGetDataSubjectID(ctx, withoutPersonalData.ProtoReflect(), loaded.conditions, false, "", blindIndexes)
 	if err != nil {
 		return nil, fmt.Errorf("error clearing personal data fields: %w", err)
 	}
@@ -65,12 +97,22 @@ func (p *Privacy) Encrypt(ctx context.Context, message proto.Message) (proto.Mes
 		return nil, errors.New("message does not contain a data subject id")
 	}
 
+	bucket, bucketFromClock, err := keyBucket(message.ProtoReflect(), p.clock())
+	if err != nil {
+		return nil, fmt.Errorf("error getting key bucket: %w", err)
+	}
+
 	marshaled, err := proto.Marshal(message)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling message: %w", err)
 	}
 
-	cipherText, err := p.crypter.Encrypt(ctx, *dataSubjectID, marshaled)
+	marshaled, compression, err := p.compress(marshaled)
+	if err != nil {
+		return nil, fmt.Errorf("error compressing message: %w", err)
+	}
+
+	cipherText, err := p.crypter.Encrypt(ctx, keyScope(*dataSubjectID, bucket), marshaled)
 	if err != nil {
 		return nil, fmt.Errorf("error encrypting message: %w", err)
 	}
@@ -80,64 +122,138 @@ func (p *Privacy) Encrypt(ctx context.Context, message proto.Message) (proto.Mes
 		return nil, fmt.Errorf("error creating any message: %w", err)
 	}
 
-	return privacy.Envelope_builder{
+	envelope := privacy.Envelope_builder{
 		Message:       anyMessage,
 		EncryptedData: cipherText,
-	}.Build(), nil
+	}.Build()
+
+	if len(blindIndexes) > 0 {
+		envelope.SetBlindIndexes(blindIndexes)
+	}
+
+	if bucketFromClock {
+		envelope.SetKeyBucket(bucket)
+	}
+
+	if compression != "" {
+		envelope.SetCompression(compression)
+	}
+
+	return envelope, nil
 }
 
 func (p *Privacy) Decrypt(ctx context.Context, message proto.Message) (proto.Message, error) {
+	decrypted, _, err := p.DecryptWithReport(ctx, message)
+	return decrypted, err
+}
+
+// DecryptWithReport decrypts the message like Decrypt and returns a report describing the outcome.
+func (p *Privacy) DecryptWithReport(ctx context.Context, message proto.Message) (proto.Message, *DecryptReport, error) {
+	report := &DecryptReport{}
+
 	envelope, ok := message.(*privacy.Envelope)
 	if !ok {
-		return message, nil
+		return message, report, nil
 	}
 
 	message, err := envelope.GetMessage().UnmarshalNew()
 	if err != nil {
-		return nil, fmt.Errorf("error unmarshaling message: %w", err)
+		return nil, nil, fmt.Errorf("error unmarshaling message: %w", err)
+	}
+
+	loaded, err := p.loadMessage(message)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	expiredFilter, allExpired := p.expiredFieldFilter(loaded.retention, message.ProtoReflect(), report)
+
+	if allExpired {
+		err := applyFallbackToPersonalDataFields(message.ProtoReflect(), loaded.conditions, expiredFilter)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error applying fallback to expired personal data fields: %w", err)
+		}
+
+		report.Outcome = DecryptOutcomeExpired
+
+		return message, report, nil
 	}
 
-	dataSubjectID, err := getDataSubjectID(message.ProtoReflect())
+	scope, err := p.envelopeKeyScope(envelope, message.ProtoReflect())
 	if err != nil {
-		return nil, fmt.Errorf("error getting data subject id: %w", err)
+		return nil, nil, err
 	}
 
-	plainTextBytes, err := p.crypter.Decrypt(ctx, *dataSubjectID, envelope.GetEncryptedData())
+	plainTextBytes, err := p.decrypt(ctx, scope, envelope.GetEncryptedData(), report)
 	if err != nil {
-		return nil, fmt.Errorf("error decrypting message: %w", err)
+		return nil, nil, fmt.Errorf("error decrypting message: %w", err)
 	}
 
-	if plainTextBytes == nil {
-		err := applyFallbackToPersonalDataFields(message.ProtoReflect())
+	if report.Outcome != DecryptOutcomeDecrypted {
+		// All fields are changed, but the expired filter is still consulted so that expired fields are reported
+		filter := anyFieldFilter(expiredFilter, allFieldsFilter)
+
+		err := applyFallbackToPersonalDataFields(message.ProtoReflect(), loaded.conditions, filter)
 		if err != nil {
-			return nil, fmt.Errorf("error applying fallback to personal data fields: %w", err)
+			return nil, nil, fmt.Errorf("error applying fallback to personal data fields: %w", err)
 		}
 	} else {
+		plainTextBytes, err = p.decompress(plainTextBytes, envelope.GetCompression())
+		if err != nil {
+			return nil, nil, fmt.Errorf("error decompressing message: %w", err)
+		}
+
 		decryptedMessage := message.ProtoReflect().New().Interface()
 
 		err = proto.Unmarshal(plainTextBytes, decryptedMessage)
 		if err != nil {
-			return nil, fmt.Errorf("error unmarshaling decrypted message: %w", err)
+			return nil, nil, fmt.Errorf("error unmarshaling decrypted message: %w", err)
 		}
 
-		return decryptedMessage, nil
+		if filter := anyFieldFilter(expiredFilter, p.unauthorizedFieldFilter(ctx, decryptedMessage.ProtoReflect().Descriptor())); filter != nil {
+			err := applyFallbackToPersonalDataFields(decryptedMessage.ProtoReflect(), loaded.conditions, filter)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error applying fallback to expired or unauthorized personal data fields: %w", err)
+			}
+		}
+
+		return decryptedMessage, report, nil
 	}
 
-	return message, nil
+	return message, report, nil
 }
 
-func New(crypter Crypter) *Privacy {
+// New returns a Privacy instance that encrypts personal data using crypter. If an option is invalid, such as
+// WithCompression with a nil compressor, the methods of the instance that process messages, such as Encrypt and
+// Decrypt, return an error describing it.
+func New(crypter Crypter, opts ...Option) *Privacy {
 	p := &Privacy{
-		crypter: crypter,
+		crypter:       crypter,
+		policy:        AllowAllPolicy,
+		clock:         time.Now,
+		decompressors: map[string]Compressor{gzipCompressorName: gzipCompressor{}},
+	}
+
+	for _, opt := range opts {
+		opt(p)
 	}
 
 	p.cache.Store(&messageCache{})
 	return p
 }
 
-func maskPersonalDataFieldsAndGetDataSubjectID(m protoreflect.Message) (*string, error) {
+// maskPersonalDataFieldsAndGetDataSubjectID resets personal data fields to their default values and returns the data
+// subject id. Fields with a condition are only treated as personal data if their condition is true. Fields with a mask
+// are set to their masked value and pseudonymized fields are set to their token. If redacting is true, fields with a
+// hash mask are treated as fields without a mask if no salt is set, instead of failing. If marker is not empty, string
+// and bytes fields without a mask are set to the marker instead. If blindIndexes is not nil, blind indexes are computed
+// for fields with a blind index and written to their target field, or added to blindIndexes if they do not have a
+// target field.
+func (p *Privacy) maskPersonalDataFieldsAndGetDataSubjectID(ctx context.Context, m protoreflect.Message, conditions conditions, redacting bool, marker string, blindIndexes map[string]string) (*string, error) {
 	var dataSubjectID *string
 
+	tokenizer := p.newTokenizer(ctx, m)
+
 	err := protorange.Range(m, func(v protopath.Values) error {
 		privacyField, fd := getPrivacyFieldOptions(v)
 
@@ -150,8 +266,42 @@ func maskPersonalDataFieldsAndGetDataSubjectID(m protoreflect.Message) (*string,
 				return nil
 			}
 
+			if !conditions.isPersonalData(m, fd) {
+				return nil
+			}
+
+			// Empty values are not indexed, as all of them would share the same index
+			if blindIndex := privacyField.GetPersonalData().GetBlindIndex(); blindIndexes != nil && blindIndex != nil && fd.Kind() == protoreflect.StringKind && !fd.IsList() && normalizeBlindIndexValue(blindIndex.GetNormalization(), v.Index(-1).Value.String()) != "" {
+				index, err := computeBlindIndex(blindIndex, p.blindIndexKey, v.Index(-1).Value.String())
+				if err != nil {
+					return fmt.Errorf("error computing blind index for field %s: %w", fd.FullName(), err)
+				}
+
+				if blindIndex.GetField() != "" {
+					m.Set(m.Descriptor().Fields().ByName(protoreflect.Name(blindIndex.GetField())), protoreflect.ValueOfString(index))
+				} else {
+					blindIndexes[string(fd.FullName())] = index
+				}
+			}
+
 			if fd.IsMap() || fd.IsList() || fd.Message() != nil {
 				m.Clear(fd)
+			} else if pseudonymize := privacyField.GetPersonalData().GetPseudonymize(); pseudonymize != nil && fd.Kind() == protoreflect.StringKind {
+				token, err := tokenizer.token(pseudonymize, v.Index(-1).Value.String())
+				if err != nil {
+					return fmt.Errorf("error pseudonymizing field %s: %w", fd.FullName(), err)
+				}
+				m.Set(fd, protoreflect.ValueOfString(token))
+			} else if mask := privacyField.GetPersonalData().GetMask(); mask.HasStrategy() && fd.Kind() == protoreflect.StringKind && !(redacting && p.maskRequiresSalt(mask)) {
+				masked, err := applyMask(mask, v.Index(-1).Value.String(), p.maskHashSalt)
+				if err != nil {
+					return fmt.Errorf("error masking field %s: %w", fd.FullName(), err)
+				}
+				m.Set(fd, protoreflect.ValueOfString(masked))
+			} else if marker != "" && fd.Kind() == protoreflect.StringKind {
+				m.Set(fd, protoreflect.ValueOfString(marker))
+			} else if marker != "" && fd.Kind() == protoreflect.BytesKind {
+				m.Set(fd, protoreflect.ValueOfBytes([]byte(marker)))
 			} else {
 				m.Set(fd, fd.Default())
 			}
@@ -180,7 +330,10 @@ func getDataSubjectID(m protoreflect.Message) (*string, error) {
 	return dataSubjectID, err
 }
 
-func applyFallbackToPersonalDataFields(m protoreflect.Message) error {
+// applyFallbackToPersonalDataFields clears personal data fields or sets them to their fallback values. Fields with a
+// condition are only changed if their condition is true. If filter is not nil, only the fields selected by the filter
+// are changed.
+func applyFallbackToPersonalDataFields(m protoreflect.Message, conditions conditions, filter fieldFilter) error {
 	err := protorange.Range(m, func(v protopath.Values) error {
 		privacyField, fd := getPrivacyFieldOptions(v)
 
@@ -189,11 +342,23 @@ func applyFallbackToPersonalDataFields(m protoreflect.Message) error {
 			return nil
 		}
 
+		if filter != nil && !filter(fd, personalData) {
+			return nil
+		}
+
 		parentMessage, ok := v.Index(-2).Value.Interface().(protoreflect.Message)
 		if !ok {
 			return nil
 		}
 
+		if !conditions.isPersonalData(parentMessage, fd) {
+			return nil
+		}
+
+		if target := personalData.GetBlindIndex().GetField(); target != "" {
+			parentMessage.Clear(parentMessage.Descriptor().Fields().ByName(protoreflect.Name(target)))
+		}
+
 		if personalData.HasFallback() {
 			var value any
 
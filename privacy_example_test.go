@@ -3,6 +3,7 @@ package protoprivacy
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 
 	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
@@ -33,8 +34,18 @@ func (c *exampleCrypter) Decrypt(_ context.Context, dataSubjectID string, cipher
 	return dst[:n], nil
 }
 
-func (c *exampleCrypter) DeleteKey(dataSubjectID string) {
+func (c *exampleCrypter) Shred(_ context.Context, dataSubjectID string) error {
 	c.deletedKeys[dataSubjectID] = struct{}{}
+	return nil
+}
+
+func (c *exampleCrypter) ShredPrefix(_ context.Context, _ string) error {
+	return errors.New("shredding by prefix is not supported")
+}
+
+func (c *exampleCrypter) IsShredded(_ context.Context, dataSubjectID string) (bool, error) {
+	_, ok := c.deletedKeys[dataSubjectID]
+	return ok, nil
 }
 
 func Example() {
@@ -75,7 +86,10 @@ func Example() {
 
 	fmt.Println(decrypted)
 
-	c.DeleteKey("1234567890")
+	err = p.Shred(context.Background(), encrypted)
+	if err != nil {
+		panic(err)
+	}
 
 	decrypted, err = p.Decrypt(context.Background(), encrypted)
 	if err != nil {
@@ -3,8 +3,10 @@ package protoprivacy
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"testing"
 
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
 	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
 	"google.golang.org/protobuf/proto"
 )
@@ -417,3 +419,128 @@ func TestMessagesWithoutPrivacyFieldsPassesThrough(t *testing.T) {
 		t.Error("Encrypted and decrypted messages should be identical for messages without privacy fields")
 	}
 }
+
+func TestPrivacyMaskedPersonalData(t *testing.T) {
+	msg := testprotos.TestMask_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("4111111111111111"),
+		Data2: proto.String("John"),
+		Data3: proto.String("john@example.com"),
+		Data4: proto.String("Doe"),
+		Data5: proto.String("secret"),
+	}.Build()
+
+	p := New(fakeCrypter{}, WithMaskHashSalt([]byte("salt")))
+
+	envelope, err := p.Encrypt(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	redacted, err := envelope.(*privacy.Envelope).GetMessage().UnmarshalNew()
+	if err != nil {
+		t.Fatalf("Error unmarshaling redacted message: %v", err)
+	}
+
+	masked := redacted.(*testprotos.TestMask)
+
+	for _, tt := range []struct {
+		explanation string
+		actual      string
+		expected    string
+	}{
+		{explanation: "Keep last", actual: masked.GetData1(), expected: "************1111"},
+		{explanation: "Keep first", actual: masked.GetData2(), expected: "Jo**"},
+		{explanation: "Email domain", actual: masked.GetData3(), expected: "****@example.com"},
+		{explanation: "Fixed length", actual: masked.GetData4(), expected: "###"},
+		{explanation: "Hash", actual: masked.GetData5(), expected: "98e5340f0f4f96d2b80c2a90da0d03cf46c35e9492918cc7af73d9a39efa5981"},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			if tt.actual != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, tt.actual)
+			}
+		})
+	}
+
+	decrypted, err := p.Decrypt(context.Background(), envelope)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if !proto.Equal(msg, decrypted) {
+		t.Error("Decrypted message does not match original message")
+	}
+
+	shredded, err := New(fakeDeletedDataSubjectCrypter{}).Decrypt(context.Background(), envelope)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if !proto.Equal(testprotos.TestMask_builder{Id: proto.String("123")}.Build(), shredded) {
+		t.Error("Masked values should be cleared after the data subject has been deleted")
+	}
+}
+
+func TestPrivacyMaskedShortPersonalData(t *testing.T) {
+	msg := testprotos.TestMask_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("1111"),
+		Data2: proto.String("J"),
+	}.Build()
+
+	envelope, err := New(fakeCrypter{}).Encrypt(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	redacted, err := envelope.(*privacy.Envelope).GetMessage().UnmarshalNew()
+	if err != nil {
+		t.Fatalf("Error unmarshaling redacted message: %v", err)
+	}
+
+	masked := redacted.(*testprotos.TestMask)
+
+	for _, tt := range []struct {
+		explanation string
+		actual      string
+		expected    string
+	}{
+		{explanation: "Keep last", actual: masked.GetData1(), expected: "****"},
+		{explanation: "Keep first", actual: masked.GetData2(), expected: "*"},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			if tt.actual != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, tt.actual)
+			}
+		})
+	}
+}
+
+func TestPrivacyMaskedHashWithoutSalt(t *testing.T) {
+	msg := testprotos.TestMask_builder{
+		Id:    proto.String("123"),
+		Data5: proto.String("secret"),
+	}.Build()
+
+	if _, err := New(fakeCrypter{}).Encrypt(context.Background(), msg); !errors.Is(err, errMaskHashSaltNotSet) {
+		t.Errorf("Expected error encrypting message with hash mask without salt, got %v", err)
+	}
+
+	redacted, err := Redact(msg)
+	if err != nil {
+		t.Fatalf("Error redacting message: %v", err)
+	}
+
+	if redacted.(*testprotos.TestMask).GetData5() != "" {
+		t.Errorf("Expected hash masked field to be cleared when redacting without salt, got %q", redacted.(*testprotos.TestMask).GetData5())
+	}
+
+	redacted, err = New(nil, WithRedactionMarker("[REDACTED]")).Redact(msg)
+	if err != nil {
+		t.Fatalf("Error redacting message: %v", err)
+	}
+
+	if actual := redacted.(*testprotos.TestMask).GetData5(); actual != "[REDACTED]" {
+		t.Errorf("Expected hash masked field to be set to the redaction marker when redacting without salt, got %q", actual)
+	}
+}
@@ -0,0 +1,233 @@
+package protoprivacytest
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/Boostport/protoprivacy"
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protopath"
+	"google.golang.org/protobuf/reflect/protorange"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// minLeakSize is the minimum size of personal data values that AssertRoundTrip looks for in redacted messages, as
+// shorter values can appear in them by chance.
+const minLeakSize = 4
+
+// AssertRoundTrip encrypts msg using p, checks that the envelope is redacted using AssertRedacted and that the values
+// of string and bytes personal data fields of msg do not appear in the redacted message, and checks that decrypting the
+// envelope returns msg. It returns the envelope. Fields with a mask or a token are not checked for their values, and
+// neither are values shorter than 4 bytes.
+func AssertRoundTrip(t testing.TB, p *protoprivacy.Privacy, msg proto.Message) proto.Message {
+	t.Helper()
+
+	ctx := context.Background()
+
+	encrypted, err := p.Encrypt(ctx, msg)
+	if err != nil {
+		t.Errorf("Error encrypting message: %v", err)
+		return nil
+	}
+
+	if envelope, ok := encrypted.(*privacy.Envelope); ok {
+		AssertRedacted(t, envelope)
+
+		for _, value := range personalDataValues(msg.ProtoReflect()) {
+			if bytes.Contains(envelope.GetMessage().GetValue(), value) {
+				t.Errorf("Redacted message contains personal data value %q", value)
+			}
+		}
+	}
+
+	decrypted, err := p.Decrypt(ctx, encrypted)
+	if err != nil {
+		t.Errorf("Error decrypting message: %v", err)
+		return encrypted
+	}
+
+	if !proto.Equal(decrypted, msg) {
+		t.Errorf("Decrypted message does not match original message, expected %v, got %v", msg, decrypted)
+	}
+
+	return encrypted
+}
+
+// AssertRedacted checks that the redacted message in an envelope returned by Privacy.Encrypt does not contain personal
+// data: personal data fields must be unset or set to their default value, unless they are string fields with a mask or
+// a token. Fields with a condition are not checked, as their condition is evaluated on the original message.
+func AssertRedacted(t testing.TB, envelope proto.Message) {
+	t.Helper()
+
+	e, ok := envelope.(*privacy.Envelope)
+	if !ok {
+		t.Errorf("Message is not an envelope, got %T", envelope)
+		return
+	}
+
+	redacted, err := e.GetMessage().UnmarshalNew()
+	if err != nil {
+		t.Errorf("Error unmarshaling redacted message: %v", err)
+		return
+	}
+
+	rangePersonalDataFields(redacted.ProtoReflect(), func(_ protoreflect.Message, fd protoreflect.FieldDescriptor, personalData *privacy.PrivacyFieldOptions_PersonalData, value protoreflect.Value) {
+		if personalData.GetCondition() != "" || isDerived(fd, personalData) {
+			return
+		}
+
+		if fd.IsList() || fd.IsMap() || fd.Message() != nil || !value.Equal(fd.Default()) {
+			t.Errorf("Personal data field %s is not redacted, got %v", fd.FullName(), value)
+		}
+	})
+}
+
+// AssertShreddedFallbacks encrypts msg using p, shreds its data subject using Privacy.Shred and checks that decrypting
+// the envelope returns msg with its personal data fields set to their fallback values or cleared. The crypter of p must
+// implement protoprivacy.Shredder. Fields with a condition are not checked.
+func AssertShreddedFallbacks(t testing.TB, p *protoprivacy.Privacy, msg proto.Message) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	encrypted, err := p.Encrypt(ctx, msg)
+	if err != nil {
+		t.Errorf("Error encrypting message: %v", err)
+		return
+	}
+
+	if err := p.Shred(ctx, encrypted); err != nil {
+		t.Errorf("Error shredding data subject: %v", err)
+		return
+	}
+
+	decrypted, report, err := p.DecryptWithReport(ctx, encrypted)
+	if err != nil {
+		t.Errorf("Error decrypting message: %v", err)
+		return
+	}
+
+	if report.Outcome != protoprivacy.DecryptOutcomeKeyShredded && report.Outcome != protoprivacy.DecryptOutcomeKeyNotFound {
+		t.Errorf("Expected outcome %s or %s, got %s", protoprivacy.DecryptOutcomeKeyShredded, protoprivacy.DecryptOutcomeKeyNotFound, report.Outcome)
+	}
+
+	expected := proto.Clone(msg)
+	applyFallbacks(expected.ProtoReflect())
+
+	decrypted = proto.Clone(decrypted)
+	clearConditionalFields(expected.ProtoReflect())
+	clearConditionalFields(decrypted.ProtoReflect())
+
+	if !proto.Equal(decrypted, expected) {
+		t.Errorf("Decrypted message does not match message with fallback values, expected %v, got %v", expected, decrypted)
+	}
+}
+
+// applyFallbacks sets the personal data fields of m to their fallback values or clears them, and clears the targets of
+// their blind indexes. Fields without explicit presence are cleared when redacted, so they are not set to their
+// fallback values.
+func applyFallbacks(m protoreflect.Message) {
+	rangePersonalDataFields(m, func(parent protoreflect.Message, fd protoreflect.FieldDescriptor, personalData *privacy.PrivacyFieldOptions_PersonalData, _ protoreflect.Value) {
+		if personalData.GetCondition() != "" {
+			return
+		}
+
+		if target := personalData.GetBlindIndex().GetField(); target != "" {
+			parent.Clear(parent.Descriptor().Fields().ByName(protoreflect.Name(target)))
+		}
+
+		fallback := personalData.ProtoReflect()
+		fallbackField := fallback.WhichOneof(fallback.Descriptor().Oneofs().ByName("fallback"))
+
+		if fallbackField != nil && fd.HasPresence() {
+			parent.Set(fd, fallback.Get(fallbackField))
+		} else {
+			parent.Clear(fd)
+		}
+	})
+}
+
+func clearConditionalFields(m protoreflect.Message) {
+	rangePersonalDataFields(m, func(parent protoreflect.Message, fd protoreflect.FieldDescriptor, personalData *privacy.PrivacyFieldOptions_PersonalData, _ protoreflect.Value) {
+		if personalData.GetCondition() != "" {
+			parent.Clear(fd)
+		}
+	})
+}
+
+// personalDataValues returns the values of string and bytes personal data fields in m that are not derived and do not
+// have a condition, including the elements of lists and the values of maps.
+func personalDataValues(m protoreflect.Message) [][]byte {
+	var values [][]byte
+
+	add := func(kind protoreflect.Kind, value protoreflect.Value) {
+		var b []byte
+
+		switch kind {
+		case protoreflect.StringKind:
+			b = []byte(value.String())
+		case protoreflect.BytesKind:
+			b = value.Bytes()
+		}
+
+		if len(b) >= minLeakSize {
+			values = append(values, b)
+		}
+	}
+
+	rangePersonalDataFields(m, func(_ protoreflect.Message, fd protoreflect.FieldDescriptor, personalData *privacy.PrivacyFieldOptions_PersonalData, value protoreflect.Value) {
+		if personalData.GetCondition() != "" || isDerived(fd, personalData) {
+			return
+		}
+
+		switch {
+		case fd.IsList():
+			for i := range value.List().Len() {
+				add(fd.Kind(), value.List().Get(i))
+			}
+		case fd.IsMap():
+			value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				add(fd.MapValue().Kind(), v)
+				return true
+			})
+		default:
+			add(fd.Kind(), value)
+		}
+	})
+
+	return values
+}
+
+// isDerived reports whether the personal data field is replaced by a value derived from it when redacted.
+func isDerived(fd protoreflect.FieldDescriptor, personalData *privacy.PrivacyFieldOptions_PersonalData) bool {
+	return fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() &&
+		(personalData.GetMask().HasStrategy() || personalData.HasPseudonymize())
+}
+
+// rangePersonalDataFields calls fn for every populated personal data field in m, including those of nested messages.
+func rangePersonalDataFields(m protoreflect.Message, fn func(parent protoreflect.Message, fd protoreflect.FieldDescriptor, personalData *privacy.PrivacyFieldOptions_PersonalData, value protoreflect.Value)) {
+	_ = protorange.Range(m, func(v protopath.Values) error {
+		fd := v.Path.Index(-1).FieldDescriptor()
+		if fd == nil {
+			return nil
+		}
+
+		privacyField, _ := proto.GetExtension(fd.Options(), privacy.E_Field).(*privacy.PrivacyFieldOptions)
+
+		personalData := privacyField.GetPersonalData()
+		if personalData == nil {
+			return nil
+		}
+
+		parent, ok := v.Index(-2).Value.Interface().(protoreflect.Message)
+		if !ok {
+			return nil
+		}
+
+		fn(parent, fd, personalData, v.Index(-1).Value)
+
+		return nil
+	})
+}
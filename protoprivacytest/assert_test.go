@@ -0,0 +1,177 @@
+package protoprivacytest
+
+import (
+	"testing"
+
+	"github.com/Boostport/protoprivacy"
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// recordingT records whether an assertion failed instead of failing the test.
+type recordingT struct {
+	testing.TB
+	failed bool
+}
+
+func (t *recordingT) Helper() {}
+
+func (t *recordingT) Errorf(string, ...any) {
+	t.failed = true
+}
+
+func testMessages() []struct {
+	explanation string
+	msg         proto.Message
+} {
+	return []struct {
+		explanation string
+		msg         proto.Message
+	}{
+		{
+			explanation: "Nested, repeated and map fields",
+			msg: testprotos.TestMessage_builder{
+				Id:    proto.String("123"),
+				Data1: proto.String("secret1"),
+				Data2: testprotos.TestNested1_builder{
+					Data1: proto.String("secret2"),
+					Data4: proto.String("public"),
+				}.Build(),
+				Data3: testprotos.TestNested2_builder{Data1: proto.String("secret3")}.Build(),
+				Data4: []string{"secret4", "secret5"},
+				Data7: map[string]string{"key": "secret6"},
+			}.Build(),
+		},
+		{
+			explanation: "Fallbacks",
+			msg: testprotos.TestFallbackTypes_builder{
+				Id:     proto.String("123"),
+				Data1:  proto.Float64(2),
+				Data3:  proto.Int32(2),
+				Data13: proto.Bool(false),
+				Data14: proto.String("secret"),
+				Data15: []byte("secret"),
+			}.Build(),
+		},
+		{
+			explanation: "Masks",
+			msg: testprotos.TestMask_builder{
+				Id:    proto.String("123"),
+				Data1: proto.String("5555444433331234"),
+				Data3: proto.String("someone@example.com"),
+				Data5: proto.String("secret"),
+			}.Build(),
+		},
+		{
+			explanation: "Blind indexes",
+			msg: testprotos.TestBlindIndex_builder{
+				Id:    proto.String("123"),
+				Data1: proto.String("someone@example.com"),
+				Data2: proto.String("+1 555 0100"),
+			}.Build(),
+		},
+		{
+			explanation: "Conditions",
+			msg: testprotos.TestCondition_builder{
+				Id:      proto.String("123"),
+				Channel: proto.String("customer"),
+				Notes:   proto.String("secret"),
+				Address: testprotos.TestCondition_Address_builder{
+					Country: proto.String("US"),
+					Line1:   proto.String("1 Main Street"),
+				}.Build(),
+			}.Build(),
+		},
+	}
+}
+
+func newTestPrivacy() *protoprivacy.Privacy {
+	return protoprivacy.New(NewFakeCrypter(), protoprivacy.WithBlindIndexKey([]byte("blind index key")), protoprivacy.WithMaskHashSalt([]byte("mask hash salt")))
+}
+
+func TestAssertRoundTrip(t *testing.T) {
+	for _, tt := range testMessages() {
+		t.Run(tt.explanation, func(t *testing.T) {
+			envelope := AssertRoundTrip(t, newTestPrivacy(), tt.msg)
+
+			if _, ok := envelope.(*privacy.Envelope); !ok {
+				t.Errorf("Expected an envelope, got %T", envelope)
+			}
+		})
+	}
+}
+
+func TestAssertShreddedFallbacks(t *testing.T) {
+	for _, tt := range testMessages() {
+		t.Run(tt.explanation, func(t *testing.T) {
+			AssertShreddedFallbacks(t, newTestPrivacy(), tt.msg)
+		})
+	}
+}
+
+func TestAssertShreddedFallbacksWithoutShredder(t *testing.T) {
+	rt := &recordingT{TB: t}
+
+	// Embedding the crypter hides its Shredder methods
+	p := protoprivacy.New(struct{ protoprivacy.Crypter }{NewFakeCrypter()})
+
+	AssertShreddedFallbacks(rt, p, testMessages()[1].msg)
+
+	if !rt.failed {
+		t.Error("Expected assertion to fail for crypter that does not implement Shredder")
+	}
+}
+
+func TestAssertRedactedNotRedacted(t *testing.T) {
+	for _, tt := range []struct {
+		explanation string
+		msg         proto.Message
+	}{
+		{
+			explanation: "Scalar",
+			msg: testprotos.TestMessage_builder{
+				Id:    proto.String("123"),
+				Data1: proto.String("secret"),
+			}.Build(),
+		},
+		{
+			explanation: "Repeated",
+			msg: testprotos.TestMessage_builder{
+				Id:    proto.String("123"),
+				Data4: []string{"secret"},
+			}.Build(),
+		},
+		{
+			explanation: "Message",
+			msg: testprotos.TestMessage_builder{
+				Id:    proto.String("123"),
+				Data3: testprotos.TestNested2_builder{Data1: proto.String("secret")}.Build(),
+			}.Build(),
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			anyMessage, err := anypb.New(tt.msg)
+			if err != nil {
+				t.Fatalf("Error creating any message: %v", err)
+			}
+
+			rt := &recordingT{TB: t}
+
+			AssertRedacted(rt, privacy.Envelope_builder{Message: anyMessage}.Build())
+
+			if !rt.failed {
+				t.Error("Expected assertion to fail for envelope with personal data in the redacted message")
+			}
+		})
+	}
+
+	rt := &recordingT{TB: t}
+
+	AssertRedacted(rt, testprotos.TestMessage_builder{Id: proto.String("123")}.Build())
+
+	if !rt.failed {
+		t.Error("Expected assertion to fail for message that is not an envelope")
+	}
+}
@@ -0,0 +1,143 @@
+// Package protoprivacytest provides utilities for testing code that uses protoprivacy: a fake crypter that records its
+// calls and supports shredding, and assertions driven by the privacy annotations of messages.
+package protoprivacytest
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Boostport/protoprivacy"
+	"github.com/Boostport/protoprivacy/internal/aead"
+)
+
+// Call is a call made to a FakeCrypter.
+type Call struct {
+	// Method is the name of the method that was called, such as "Encrypt" or "Shred".
+	Method string
+
+	// DataSubjectID is the data subject id the method was called with, the prefix for ShredPrefix or the suffix for
+	// ShredSuffix.
+	DataSubjectID string
+}
+
+// FakeCrypter is a protoprivacy.Crypter, protoprivacy.Shredder and protoprivacy.SuffixShredder for tests. It records
+// the calls made to it and encrypts data using AES-256-GCM with a random key per data subject. Keys are only kept in
+// memory, so it MUST NOT be used in production.
+type FakeCrypter struct {
+	mu    sync.Mutex
+	keys  map[string][]byte
+	calls []Call
+}
+
+// NewFakeCrypter returns a FakeCrypter without any keys.
+func NewFakeCrypter() *FakeCrypter {
+	return &FakeCrypter{
+		keys: make(map[string][]byte),
+	}
+}
+
+// Calls returns the calls made to the FakeCrypter in the order they were made.
+func (c *FakeCrypter) Calls() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return slices.Clone(c.calls)
+}
+
+// Encrypt encrypts cleartext using the key of the data subject, creating the key if it does not exist.
+func (c *FakeCrypter) Encrypt(ctx context.Context, dataSubjectID string, cleartext []byte) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.record("Encrypt", dataSubjectID)
+
+	key, ok := c.keys[dataSubjectID]
+	if !ok {
+		var err error
+
+		key, err = aead.NewKey()
+		if err != nil {
+			return nil, err
+		}
+
+		c.keys[dataSubjectID] = key
+	}
+
+	return aead.NewHandle(key, dataSubjectID).Encrypt(ctx, cleartext)
+}
+
+// Decrypt decrypts ciphertext using the key of the data subject. It returns protoprivacy.ErrKeyShredded if the data
+// subject has been shredded.
+func (c *FakeCrypter) Decrypt(ctx context.Context, dataSubjectID string, ciphertext []byte) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.record("Decrypt", dataSubjectID)
+
+	key, ok := c.keys[dataSubjectID]
+	if !ok {
+		return nil, protoprivacy.ErrKeyShredded
+	}
+
+	return aead.NewHandle(key, dataSubjectID).Decrypt(ctx, ciphertext)
+}
+
+// Shred deletes the key of the data subject.
+func (c *FakeCrypter) Shred(_ context.Context, dataSubjectID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.record("Shred", dataSubjectID)
+	delete(c.keys, dataSubjectID)
+
+	return nil
+}
+
+// ShredPrefix deletes the keys of all data subject ids starting with the prefix.
+func (c *FakeCrypter) ShredPrefix(_ context.Context, prefix string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.record("ShredPrefix", prefix)
+
+	for id := range c.keys {
+		if strings.HasPrefix(id, prefix) {
+			delete(c.keys, id)
+		}
+	}
+
+	return nil
+}
+
+// ShredSuffix deletes the keys of all data subject ids ending with the suffix.
+func (c *FakeCrypter) ShredSuffix(_ context.Context, suffix string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.record("ShredSuffix", suffix)
+
+	for id := range c.keys {
+		if strings.HasSuffix(id, suffix) {
+			delete(c.keys, id)
+		}
+	}
+
+	return nil
+}
+
+// IsShredded reports whether the data subject does not have a key.
+func (c *FakeCrypter) IsShredded(_ context.Context, dataSubjectID string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.record("IsShredded", dataSubjectID)
+	_, ok := c.keys[dataSubjectID]
+
+	return !ok, nil
+}
+
+func (c *FakeCrypter) record(method string, dataSubjectID string) {
+	c.calls = append(c.calls, Call{Method: method, DataSubjectID: dataSubjectID})
+}
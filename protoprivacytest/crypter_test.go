@@ -0,0 +1,50 @@
+package protoprivacytest
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Boostport/protoprivacy"
+	"github.com/Boostport/protoprivacy/crypttest"
+)
+
+func TestFakeCrypter(t *testing.T) {
+	crypttest.TestCrypter(t, func(t *testing.T) protoprivacy.Crypter {
+		return NewFakeCrypter()
+	})
+}
+
+func TestFakeCrypterCalls(t *testing.T) {
+	ctx := context.Background()
+	c := NewFakeCrypter()
+
+	ciphertext, err := c.Encrypt(ctx, "user:123", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if _, err := c.Decrypt(ctx, "user:123", ciphertext); err != nil {
+		t.Fatalf("Error decrypting: %v", err)
+	}
+
+	if err := c.ShredPrefix(ctx, "user:"); err != nil {
+		t.Fatalf("Error shredding prefix: %v", err)
+	}
+
+	if _, err := c.Decrypt(ctx, "user:123", ciphertext); !errors.Is(err, protoprivacy.ErrKeyShredded) {
+		t.Errorf("Expected ErrKeyShredded for shredded data subject, got %v", err)
+	}
+
+	expected := []Call{
+		{Method: "Encrypt", DataSubjectID: "user:123"},
+		{Method: "Decrypt", DataSubjectID: "user:123"},
+		{Method: "ShredPrefix", DataSubjectID: "user:"},
+		{Method: "Decrypt", DataSubjectID: "user:123"},
+	}
+
+	if calls := c.Calls(); !slices.Equal(calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+}
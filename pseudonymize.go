@@ -0,0 +1,81 @@
+package protoprivacy
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TokenKeyProvider provides the keys used to generate deterministic tokens for pseudonymized personal data fields.
+type TokenKeyProvider interface {
+	// TokenKey returns the key for the scope. The scope is the data subject id for fields pseudonymized per data
+	// subject, which is the default, and an empty string for fields pseudonymized globally. If the key has been deleted, TokenKey should
+	// return nil as the key and nil as the error, in which case the field is cleared.
+	TokenKey(ctx context.Context, scope string) ([]byte, error)
+}
+
+// tokenizer generates tokens for pseudonymized fields in a single message, fetching each key at most once.
+type tokenizer struct {
+	ctx      context.Context
+	provider TokenKeyProvider
+	message  protoreflect.Message
+	keys     map[string][]byte
+}
+
+func (p *Privacy) newTokenizer(ctx context.Context, m protoreflect.Message) *tokenizer {
+	return &tokenizer{
+		ctx:      ctx,
+		provider: p.tokenKeyProvider,
+		message:  m,
+		keys:     make(map[string][]byte),
+	}
+}
+
+// token returns the token for value. An empty string is returned if there is no key for the scope.
+func (t *tokenizer) token(pseudonymize *privacy.PrivacyFieldOptions_Pseudonymize, value string) (string, error) {
+	if t.provider == nil || value == "" {
+		return "", nil
+	}
+
+	var scope string
+
+	// Fields without a scope are pseudonymized per data subject, so that their tokens become unlinkable when shredding
+	if pseudonymize.GetScope() != privacy.PrivacyFieldOptions_Pseudonymize_SCOPE_GLOBAL {
+		dataSubjectID, err := getDataSubjectID(t.message)
+		if err != nil {
+			return "", fmt.Errorf("error getting data subject id: %w", err)
+		}
+
+		if dataSubjectID == nil {
+			return "", nil
+		}
+
+		scope = *dataSubjectID
+	}
+
+	key, ok := t.keys[scope]
+	if !ok {
+		var err error
+
+		key, err = t.provider.TokenKey(t.ctx, scope)
+		if err != nil {
+			return "", fmt.Errorf("error getting token key: %w", err)
+		}
+
+		t.keys[scope] = key
+	}
+
+	if key == nil {
+		return "", nil
+	}
+
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(value))
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,118 @@
+package protoprivacy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakeTokenKeyProvider struct {
+	keys map[string][]byte
+}
+
+func (f fakeTokenKeyProvider) TokenKey(_ context.Context, scope string) ([]byte, error) {
+	return f.keys[scope], nil
+}
+
+func encryptAndGetRedacted(t *testing.T, p *Privacy, msg proto.Message) *testprotos.TestPseudonymize {
+	t.Helper()
+
+	envelope, err := p.Encrypt(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	redacted, err := envelope.(*privacy.Envelope).GetMessage().UnmarshalNew()
+	if err != nil {
+		t.Fatalf("Error unmarshaling redacted message: %v", err)
+	}
+
+	return redacted.(*testprotos.TestPseudonymize)
+}
+
+func TestPseudonymize(t *testing.T) {
+	provider := fakeTokenKeyProvider{
+		keys: map[string][]byte{
+			"":    []byte("global"),
+			"123": []byte("subject-123"),
+			"456": []byte("subject-456"),
+		},
+	}
+
+	p := New(fakeCrypter{}, WithTokenKeyProvider(provider))
+
+	first := encryptAndGetRedacted(t, p, testprotos.TestPseudonymize_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("john@example.com"),
+		Data2: proto.String("john@example.com"),
+		Data3: proto.String("john@example.com"),
+	}.Build())
+
+	second := encryptAndGetRedacted(t, p, testprotos.TestPseudonymize_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("john@example.com"),
+		Data2: proto.String("john@example.com"),
+		Data3: proto.String("john@example.com"),
+	}.Build())
+
+	other := encryptAndGetRedacted(t, p, testprotos.TestPseudonymize_builder{
+		Id:    proto.String("456"),
+		Data1: proto.String("john@example.com"),
+		Data2: proto.String("john@example.com"),
+		Data3: proto.String("john@example.com"),
+	}.Build())
+
+	if first.GetData1() == "" || first.GetData1() == "john@example.com" {
+		t.Fatalf("Expected token, got %q", first.GetData1())
+	}
+
+	if first.GetData1() != second.GetData1() || first.GetData2() != second.GetData2() {
+		t.Error("Tokens for the same value should be deterministic")
+	}
+
+	if first.GetData1() != other.GetData1() {
+		t.Error("Globally scoped tokens should be identical across data subjects")
+	}
+
+	if first.GetData2() == other.GetData2() {
+		t.Error("Data subject scoped tokens should differ across data subjects")
+	}
+
+	if first.GetData1() == first.GetData2() {
+		t.Error("Globally and data subject scoped tokens should use different keys")
+	}
+
+	if first.GetData3() != first.GetData2() || first.GetData3() == other.GetData3() {
+		t.Error("Tokens without a scope should be scoped to the data subject")
+	}
+}
+
+func TestPseudonymizeWithDeletedKey(t *testing.T) {
+	for _, tt := range []struct {
+		explanation string
+		privacy     *Privacy
+	}{
+		{
+			explanation: "Deleted key",
+			privacy:     New(fakeCrypter{}, WithTokenKeyProvider(fakeTokenKeyProvider{keys: map[string][]byte{"": []byte("global")}})),
+		},
+		{
+			explanation: "No provider",
+			privacy:     New(fakeCrypter{}),
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			redacted := encryptAndGetRedacted(t, tt.privacy, testprotos.TestPseudonymize_builder{
+				Id:    proto.String("123"),
+				Data2: proto.String("john@example.com"),
+			}.Build())
+
+			if redacted.GetData2() != "" {
+				t.Errorf("Expected pseudonymized field to be cleared, got %q", redacted.GetData2())
+			}
+		})
+	}
+}
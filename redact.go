@@ -0,0 +1,45 @@
+package protoprivacy
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var defaultPrivacy = New(nil)
+
+// Redact returns a copy of the message with its personal data fields cleared. It does not require a crypter or a data
+// subject id, so it can be used to safely log or debug messages containing personal data. As no salt is set, fields
+// with a hash mask are cleared as well.
+func Redact(message proto.Message) (proto.Message, error) {
+	return defaultPrivacy.Redact(message)
+}
+
+// Redact returns a copy of the message with its personal data fields cleared or masked. If a redaction marker was
+// configured using WithRedactionMarker, string and bytes personal data fields without a mask are set to the marker
+// instead. Fields with a hash mask are redacted like fields without a mask if no salt was set using WithMaskHashSalt.
+func (p *Privacy) Redact(message proto.Message) (proto.Message, error) {
+	return p.redact(context.Background(), message)
+}
+
+func (p *Privacy) redact(ctx context.Context, message proto.Message) (proto.Message, error) {
+	loaded, err := p.loadMessage(message)
+
+	if err != nil {
+		return nil, err
+	}
+
+	redacted := proto.Clone(message)
+
+	if !loaded.hasPrivacyFields {
+		return redacted, nil
+	}
+
+	_, err = p.maskPersonalDataFieldsAndGetDataSubjectID(ctx, redacted.ProtoReflect(), loaded.conditions, true, p.redactionMarker, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error redacting personal data fields: %w", err)
+	}
+
+	return redacted, nil
+}
@@ -0,0 +1,100 @@
+package protoprivacy
+
+import (
+	"testing"
+
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRedact(t *testing.T) {
+	msg := testprotos.TestMessage_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("test"),
+		Data2: testprotos.TestNested1_builder{
+			Data1: proto.String("test1"),
+			Data2: proto.String("test2"),
+			Data3: proto.String("test3"),
+			Data4: proto.String("test4"),
+		}.Build(),
+		Data3: testprotos.TestNested2_builder{
+			Data1: proto.String("test1"),
+		}.Build(),
+		Data4: []string{"test1", "test2"},
+		Data7: map[string]string{
+			"test1": "test2",
+		},
+	}.Build()
+
+	for _, tt := range []struct {
+		explanation string
+		privacy     *Privacy
+		expected    proto.Message
+	}{
+		{
+			explanation: "Without marker",
+			privacy:     New(nil),
+			expected: testprotos.TestMessage_builder{
+				Id:    proto.String("123"),
+				Data1: proto.String(""),
+				Data2: testprotos.TestNested1_builder{
+					Data1: proto.String(""),
+					Data2: proto.String(""),
+					Data3: proto.String(""),
+					Data4: proto.String("test4"),
+				}.Build(),
+			}.Build(),
+		},
+		{
+			explanation: "With marker",
+			privacy:     New(nil, WithRedactionMarker("[REDACTED]")),
+			expected: testprotos.TestMessage_builder{
+				Id:    proto.String("123"),
+				Data1: proto.String("[REDACTED]"),
+				Data2: testprotos.TestNested1_builder{
+					Data1: proto.String("[REDACTED]"),
+					Data2: proto.String("[REDACTED]"),
+					Data3: proto.String("[REDACTED]"),
+					Data4: proto.String("test4"),
+				}.Build(),
+			}.Build(),
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			redacted, err := tt.privacy.Redact(msg)
+			if err != nil {
+				t.Fatalf("Error redacting message: %v", err)
+			}
+
+			if !proto.Equal(tt.expected, redacted) {
+				t.Errorf("Redacted message does not match expected message: %v", redacted)
+			}
+
+			if msg.GetData1() != "test" {
+				t.Error("Redact must not modify the original message")
+			}
+		})
+	}
+}
+
+func TestRedactWithoutDataSubjectID(t *testing.T) {
+	msg := testprotos.TestMessage_builder{
+		Data1: proto.String("test"),
+	}.Build()
+
+	redacted, err := Redact(msg)
+	if err != nil {
+		t.Fatalf("Error redacting message: %v", err)
+	}
+
+	if redacted.(*testprotos.TestMessage).GetData1() != "" {
+		t.Error("Personal data field should be cleared")
+	}
+}
+
+func TestRedactInvalidMessage(t *testing.T) {
+	_, err := Redact(&testprotos.InvalidMultipleDataSubjectIDs{})
+	if err == nil {
+		t.Error("Expected error redacting invalid message")
+	}
+}
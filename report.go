@@ -0,0 +1,76 @@
+package protoprivacy
+
+import "google.golang.org/protobuf/reflect/protoreflect"
+
+// DecryptOutcome describes what happened to the encrypted personal data when decrypting a message.
+type DecryptOutcome int
+
+const (
+	// DecryptOutcomeNotEncrypted means the message was not an envelope and was returned unchanged.
+	DecryptOutcomeNotEncrypted DecryptOutcome = iota
+
+	// DecryptOutcomeDecrypted means the personal data was decrypted.
+	DecryptOutcomeDecrypted
+
+	// DecryptOutcomeKeyShredded means the key of the data subject was shredded, so the personal data fields were
+	// cleared or set to their fallback values.
+	DecryptOutcomeKeyShredded
+
+	// DecryptOutcomeKeyNotFound means the key of the data subject was not found, so the personal data fields were
+	// cleared or set to their fallback values.
+	DecryptOutcomeKeyNotFound
+
+	// DecryptOutcomeExpired means the retention periods of all personal data fields expired, so the crypter was not
+	// consulted.
+	DecryptOutcomeExpired
+
+	// DecryptOutcomeDegraded means the crypter failed and the error policy degraded the result, so the personal data
+	// fields were cleared or set to their fallback values.
+	DecryptOutcomeDegraded
+)
+
+func (o DecryptOutcome) String() string {
+	switch o {
+	case DecryptOutcomeNotEncrypted:
+		return "not encrypted"
+	case DecryptOutcomeDecrypted:
+		return "decrypted"
+	case DecryptOutcomeKeyShredded:
+		return "key shredded"
+	case DecryptOutcomeKeyNotFound:
+		return "key not found"
+	case DecryptOutcomeExpired:
+		return "expired"
+	case DecryptOutcomeDegraded:
+		return "degraded"
+	}
+
+	return "unknown"
+}
+
+// DecryptReport describes the outcome of decrypting a message using DecryptWithReport.
+type DecryptReport struct {
+	// Outcome describes what happened to the encrypted personal data.
+	Outcome DecryptOutcome
+
+	// DegradedError is the crypter error that caused the result to be degraded if Outcome is DecryptOutcomeDegraded.
+	DegradedError error
+
+	// ExpiredFields contains the full names of the personal data fields that were cleared or set to their fallback
+	// values because their retention period expired.
+	ExpiredFields []protoreflect.FullName
+
+	// ReencryptRecommended reports whether the crypter recommends re-encrypting the envelope using Privacy.Reencrypt,
+	// for example because a MigratingCrypter decrypted it using its legacy crypter.
+	ReencryptRecommended bool
+}
+
+func (r *DecryptReport) addExpiredField(field protoreflect.FullName) {
+	for _, expired := range r.ExpiredFields {
+		if expired == field {
+			return
+		}
+	}
+
+	r.ExpiredFields = append(r.ExpiredFields, field)
+}
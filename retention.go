@@ -0,0 +1,176 @@
+package protoprivacy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var timestampFullName = (&timestamppb.Timestamp{}).ProtoReflect().Descriptor().FullName()
+
+// retention contains the retention settings of a message.
+type retention struct {
+	timestampField         protoreflect.FieldDescriptor
+	maxRetention           time.Duration
+	allPersonalDataExpires bool
+}
+
+// loadRetention returns the retention settings of the message or nil if the message does not have a retention
+// timestamp field.
+func loadRetention(msg protoreflect.MessageDescriptor) *retention {
+	timestampField := messageRetentionTimestampField(msg)
+	if timestampField == nil {
+		return nil
+	}
+
+	r := &retention{
+		timestampField:         timestampField,
+		allPersonalDataExpires: true,
+	}
+
+	walkFields(msg, func(f protoreflect.FieldDescriptor) bool {
+		if !fieldHasPersonalData(f) {
+			return false
+		}
+
+		if !fieldHasRetention(f) {
+			r.allPersonalDataExpires = false
+			return false
+		}
+
+		r.maxRetention = max(r.maxRetention, fieldRetention(f))
+
+		return false
+	})
+
+	return r
+}
+
+// checkTimestamp returns an error if the message has personal data fields with a retention period but does not have a
+// retention timestamp, as the retention period could otherwise not be enforced.
+func (r *retention) checkTimestamp(m protoreflect.Message) error {
+	if r == nil || r.maxRetention <= 0 || m.Has(r.timestampField) {
+		return nil
+	}
+
+	return fmt.Errorf("message %s has fields with a retention period but retention timestamp field %s is not set", m.Descriptor().FullName(), r.timestampField.Name())
+}
+
+// expiredFieldFilter returns a filter selecting the personal data fields in the message whose retention period has
+// expired and records them in the report. It also reports whether all personal data fields in the message have
+// expired. If the message does not have retention settings, nil and false are returned. If the message does not have a
+// retention timestamp, all fields with a retention period are treated as expired, so that they do not outlive it.
+func (p *Privacy) expiredFieldFilter(r *retention, m protoreflect.Message, report *DecryptReport) (fieldFilter, bool) {
+	if r == nil {
+		return nil, false
+	}
+
+	var timestamp time.Time
+	if m.Has(r.timestampField) {
+		timestamp = timestampValue(m.Get(r.timestampField).Message())
+	}
+
+	now := p.clock()
+
+	filter := func(fd protoreflect.FieldDescriptor, personalData *privacy.PrivacyFieldOptions_PersonalData) bool {
+		if !personalData.HasRetention() || now.Before(timestamp.Add(personalData.GetRetention().AsDuration())) {
+			return false
+		}
+
+		report.addExpiredField(fd.FullName())
+
+		return true
+	}
+
+	return filter, r.allPersonalDataExpires && !now.Before(timestamp.Add(r.maxRetention))
+}
+
+func validateRetention(msg protoreflect.MessageDescriptor) error {
+	var errs error
+
+	path := msg.ParentFile().Path()
+
+	numFieldsWithRetention := 0
+
+	walkFields(msg, func(f protoreflect.FieldDescriptor) bool {
+		if !fieldHasPersonalData(f) || !fieldHasRetention(f) {
+			return false
+		}
+
+		numFieldsWithRetention++
+
+		if fieldRetention(f) <= 0 {
+			errs = errors.Join(errs, fmt.Errorf("field %s has a retention period that is not positive in %s", f.FullName(), path))
+		}
+
+		return false
+	})
+
+	name := messageRetentionTimestampFieldName(msg)
+
+	if name == "" {
+		if numFieldsWithRetention > 0 {
+			errs = errors.Join(errs, fmt.Errorf("message %s has fields with a retention period but does not have a retention timestamp field in %s", msg.FullName(), path))
+		}
+
+		return errs
+	}
+
+	timestampField := msg.Fields().ByName(protoreflect.Name(name))
+
+	switch {
+	case timestampField == nil:
+		errs = errors.Join(errs, fmt.Errorf("message %s has retention timestamp field %s that does not exist in %s", msg.FullName(), name, path))
+	case timestampField.IsList() || timestampField.IsMap() || timestampField.Message() == nil || timestampField.Message().FullName() != timestampFullName:
+		errs = errors.Join(errs, fmt.Errorf("message %s has retention timestamp field %s that is not a %s in %s", msg.FullName(), timestampField.FullName(), timestampFullName, path))
+	case fieldHasPersonalData(timestampField):
+		errs = errors.Join(errs, fmt.Errorf("message %s has retention timestamp field %s that is personal data in %s", msg.FullName(), timestampField.FullName(), path))
+	}
+
+	return errs
+}
+
+func messageRetentionTimestampFieldName(msg protoreflect.MessageDescriptor) string {
+	options := msg.Options()
+
+	if options == nil {
+		return ""
+	}
+
+	privacyMessage := proto.GetExtension(options, privacy.E_Message).(*privacy.PrivacyMessageOptions)
+
+	return privacyMessage.GetRetentionTimestampField()
+}
+
+func messageRetentionTimestampField(msg protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	name := messageRetentionTimestampFieldName(msg)
+	if name == "" {
+		return nil
+	}
+
+	return msg.Fields().ByName(protoreflect.Name(name))
+}
+
+func fieldHasRetention(f protoreflect.FieldDescriptor) bool {
+	options := f.Options()
+
+	if options == nil {
+		return false
+	}
+
+	privacyField := proto.GetExtension(options, privacy.E_Field).(*privacy.PrivacyFieldOptions)
+	if privacyField == nil {
+		return false
+	}
+
+	return privacyField.GetPersonalData().HasRetention()
+}
+
+func fieldRetention(f protoreflect.FieldDescriptor) time.Duration {
+	return proto.GetExtension(f.Options(), privacy.E_Field).(*privacy.PrivacyFieldOptions).GetPersonalData().GetRetention().AsDuration()
+}
@@ -0,0 +1,274 @@
+package protoprivacy
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type countingCrypter struct {
+	fakeCrypter
+	decryptCalls int
+}
+
+func (c *countingCrypter) Decrypt(ctx context.Context, dataSubjectID string, ciphertext []byte) ([]byte, error) {
+	c.decryptCalls++
+	return c.fakeCrypter.Decrypt(ctx, dataSubjectID, ciphertext)
+}
+
+func TestDecryptWithRetention(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		explanation          string
+		proto                proto.Message
+		now                  time.Time
+		expected             proto.Message
+		expectedExpired      []protoreflect.FullName
+		expectedDecryptCalls int
+	}{
+		{
+			explanation: "Not expired",
+			proto: testprotos.TestRetention_builder{
+				Id:        proto.String("123"),
+				CreatedAt: timestamppb.New(createdAt),
+				Data1:     proto.String("test1"),
+				Data2:     proto.String("test2"),
+			}.Build(),
+			now: createdAt.Add(time.Hour),
+			expected: testprotos.TestRetention_builder{
+				Id:        proto.String("123"),
+				CreatedAt: timestamppb.New(createdAt),
+				Data1:     proto.String("test1"),
+				Data2:     proto.String("test2"),
+			}.Build(),
+			expectedDecryptCalls: 1,
+		},
+		{
+			explanation: "Partially expired",
+			proto: testprotos.TestRetention_builder{
+				Id:        proto.String("123"),
+				CreatedAt: timestamppb.New(createdAt),
+				Data1:     proto.String("test1"),
+				Data2:     proto.String("test2"),
+			}.Build(),
+			now: createdAt.Add(48 * time.Hour),
+			expected: testprotos.TestRetention_builder{
+				Id:        proto.String("123"),
+				CreatedAt: timestamppb.New(createdAt),
+				Data1:     proto.String("expired"),
+				Data2:     proto.String("test2"),
+			}.Build(),
+			expectedExpired:      []protoreflect.FullName{"boostport.privacy.testing.TestRetention.data1"},
+			expectedDecryptCalls: 1,
+		},
+		{
+			explanation: "Fully expired",
+			proto: testprotos.TestRetention_builder{
+				Id:        proto.String("123"),
+				CreatedAt: timestamppb.New(createdAt),
+				Data1:     proto.String("test1"),
+				Data2:     proto.String("test2"),
+			}.Build(),
+			now: createdAt.Add(240 * time.Hour),
+			expected: testprotos.TestRetention_builder{
+				Id:        proto.String("123"),
+				CreatedAt: timestamppb.New(createdAt),
+				Data1:     proto.String("expired"),
+			}.Build(),
+			expectedExpired:      []protoreflect.FullName{"boostport.privacy.testing.TestRetention.data1", "boostport.privacy.testing.TestRetention.data2"},
+			expectedDecryptCalls: 0,
+		},
+		{
+			explanation: "Expired with field without retention",
+			proto: testprotos.TestRetentionWithoutRetentionOnAllFields_builder{
+				Id:        proto.String("123"),
+				CreatedAt: timestamppb.New(createdAt),
+				Data1:     proto.String("test1"),
+				Data2:     proto.String("test2"),
+			}.Build(),
+			now: createdAt.Add(240 * time.Hour),
+			expected: testprotos.TestRetentionWithoutRetentionOnAllFields_builder{
+				Id:        proto.String("123"),
+				CreatedAt: timestamppb.New(createdAt),
+				Data2:     proto.String("test2"),
+			}.Build(),
+			expectedExpired:      []protoreflect.FullName{"boostport.privacy.testing.TestRetentionWithoutRetentionOnAllFields.data1"},
+			expectedDecryptCalls: 1,
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			crypter := &countingCrypter{}
+			p := New(crypter, WithClock(func() time.Time { return tt.now }))
+
+			envelope, err := p.Encrypt(context.Background(), tt.proto)
+			if err != nil {
+				t.Fatalf("Error encrypting message: %v", err)
+			}
+
+			decrypted, report, err := p.DecryptWithReport(context.Background(), envelope)
+			if err != nil {
+				t.Fatalf("Error decrypting message: %v", err)
+			}
+
+			if !proto.Equal(tt.expected, decrypted) {
+				t.Errorf("Decrypted message does not match expected message: %v", decrypted)
+			}
+
+			if !slices.Equal(tt.expectedExpired, report.ExpiredFields) {
+				t.Errorf("Expected expired fields %v, got %v", tt.expectedExpired, report.ExpiredFields)
+			}
+
+			if crypter.decryptCalls != tt.expectedDecryptCalls {
+				t.Errorf("Expected %d calls to the crypter, got %d", tt.expectedDecryptCalls, crypter.decryptCalls)
+			}
+
+			expectedOutcome := DecryptOutcomeDecrypted
+			if tt.expectedDecryptCalls == 0 {
+				expectedOutcome = DecryptOutcomeExpired
+			}
+
+			if report.Outcome != expectedOutcome {
+				t.Errorf("Expected outcome %s, got %s", expectedOutcome, report.Outcome)
+			}
+		})
+	}
+}
+
+func TestEncryptWithRetentionWithoutTimestamp(t *testing.T) {
+	msg := testprotos.TestRetention_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("test1"),
+	}.Build()
+
+	if _, err := New(fakeCrypter{}).Encrypt(context.Background(), msg); err == nil {
+		t.Error("Expected error encrypting message with retention fields without a retention timestamp")
+	}
+}
+
+func TestDecryptWithRetentionWithoutTimestamp(t *testing.T) {
+	ctx := context.Background()
+	crypter := &countingCrypter{}
+	p := New(crypter)
+
+	msg := testprotos.TestRetention_builder{
+		Id:        proto.String("123"),
+		CreatedAt: timestamppb.Now(),
+		Data1:     proto.String("test1"),
+		Data2:     proto.String("test2"),
+	}.Build()
+
+	encrypted, err := p.Encrypt(ctx, msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	// Remove the retention timestamp from the redacted message, as in envelopes encrypted before it was required
+	envelope := encrypted.(*privacy.Envelope)
+
+	redacted, err := envelope.GetMessage().UnmarshalNew()
+	if err != nil {
+		t.Fatalf("Error unmarshaling redacted message: %v", err)
+	}
+
+	redacted.(*testprotos.TestRetention).ClearCreatedAt()
+
+	anyMessage, err := anypb.New(redacted)
+	if err != nil {
+		t.Fatalf("Error creating any message: %v", err)
+	}
+
+	envelope.SetMessage(anyMessage)
+
+	decrypted, report, err := p.DecryptWithReport(ctx, envelope)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	expected := testprotos.TestRetention_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("expired"),
+	}.Build()
+
+	if !proto.Equal(expected, decrypted) {
+		t.Errorf("Decrypted message does not match expected message: %v", decrypted)
+	}
+
+	if report.Outcome != DecryptOutcomeExpired {
+		t.Errorf("Expected outcome %s, got %s", DecryptOutcomeExpired, report.Outcome)
+	}
+
+	if crypter.decryptCalls != 0 {
+		t.Errorf("Expected 0 calls to the crypter, got %d", crypter.decryptCalls)
+	}
+}
+
+func TestDecryptWithRetentionShredded(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	crypter := &fakeShredderCrypter{}
+	p := New(crypter, WithClock(func() time.Time { return createdAt.Add(48 * time.Hour) }))
+
+	msg := testprotos.TestRetention_builder{
+		Id:        proto.String("123"),
+		CreatedAt: timestamppb.New(createdAt),
+		Data1:     proto.String("test1"),
+		Data2:     proto.String("test2"),
+	}.Build()
+
+	envelope, err := p.Encrypt(ctx, msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	if err := p.Shred(ctx, msg); err != nil {
+		t.Fatalf("Error shredding data subject: %v", err)
+	}
+
+	_, report, err := p.DecryptWithReport(ctx, envelope)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if report.Outcome != DecryptOutcomeKeyShredded {
+		t.Errorf("Expected outcome %s, got %s", DecryptOutcomeKeyShredded, report.Outcome)
+	}
+
+	expectedExpired := []protoreflect.FullName{"boostport.privacy.testing.TestRetention.data1"}
+	if !slices.Equal(expectedExpired, report.ExpiredFields) {
+		t.Errorf("Expected expired fields %v, got %v", expectedExpired, report.ExpiredFields)
+	}
+}
+
+func TestDecryptWithNilClock(t *testing.T) {
+	msg := testprotos.TestRetention_builder{
+		Id:        proto.String("123"),
+		CreatedAt: timestamppb.Now(),
+		Data1:     proto.String("test1"),
+		Data2:     proto.String("test2"),
+	}.Build()
+
+	p := New(fakeCrypter{}, WithClock(nil))
+
+	envelope, err := p.Encrypt(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	decrypted, err := p.Decrypt(context.Background(), envelope)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if !proto.Equal(msg, decrypted) {
+		t.Errorf("Decrypted message does not match original message, expected %v, got %v", msg, decrypted)
+	}
+}
@@ -0,0 +1,174 @@
+package protoprivacy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Boostport/protoprivacy/frame"
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	"google.golang.org/protobuf/proto"
+)
+
+// Reencrypt decrypts the encrypted data of the envelope and encrypts it again using the crypter, so that it is
+// encrypted under the latest key version, for example after rotating keys using a RotatingCrypter. The redacted message
+// in the envelope is left untouched. If the key of the data subject has been shredded or cannot be found, the envelope
+// is returned unchanged, so shredded data stays shredded. The error policy applies when decrypting, but degrading
+// returns the error instead, as the data would otherwise be lost.
+func (p *Privacy) Reencrypt(ctx context.Context, envelope proto.Message) (proto.Message, error) {
+	e, ok := envelope.(*privacy.Envelope)
+	if !ok {
+		return nil, errors.New("message is not an envelope")
+	}
+
+	message, err := e.GetMessage().UnmarshalNew()
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling message: %w", err)
+	}
+
+	if _, err := p.loadMessage(message); err != nil {
+		return nil, err
+	}
+
+	scope, err := p.envelopeKeyScope(e, message.ProtoReflect())
+	if err != nil {
+		return nil, err
+	}
+
+	report := &DecryptReport{}
+
+	plainTextBytes, err := p.decrypt(ctx, scope, e.GetEncryptedData(), report)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting message: %w", err)
+	}
+
+	switch report.Outcome {
+	case DecryptOutcomeDegraded:
+		return nil, fmt.Errorf("error decrypting message: %w", report.DegradedError)
+	case DecryptOutcomeKeyShredded, DecryptOutcomeKeyNotFound:
+		return e, nil
+	}
+
+	cipherText, err := p.crypter.Encrypt(ctx, scope, plainTextBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting message: %w", err)
+	}
+
+	reencrypted := proto.CloneOf(e)
+	reencrypted.SetEncryptedData(cipherText)
+
+	return reencrypted, nil
+}
+
+// RotatingCrypter is a Crypter that encrypts using the crypter of the current key version and decrypts using the
+// crypter of the key version the data was encrypted with. The ciphertext is wrapped in a frame with
+// frame.AlgorithmKeyVersioned, which stores the key version, so frame.Inspect reports the key version along with the
+// frame of the wrapped ciphertext. To rotate keys, add a crypter for a new key version, make it the current version
+// and use Privacy.Reencrypt to move existing envelopes to it. Once no data is encrypted under an old key version, its
+// crypter can be removed.
+type RotatingCrypter struct {
+	current  uint64
+	crypters map[uint64]Crypter
+}
+
+// NewRotatingCrypter returns a RotatingCrypter that encrypts using the crypter of the current key version and decrypts
+// using the crypters of all key versions.
+func NewRotatingCrypter(current uint64, crypters map[uint64]Crypter) (*RotatingCrypter, error) {
+	if _, ok := crypters[current]; !ok {
+		return nil, fmt.Errorf("no crypter for current key version %d", current)
+	}
+
+	return &RotatingCrypter{
+		current:  current,
+		crypters: crypters,
+	}, nil
+}
+
+func (r *RotatingCrypter) Encrypt(ctx context.Context, dataSubjectID string, cleartext []byte) ([]byte, error) {
+	ciphertext, err := r.crypters[r.current].Encrypt(ctx, dataSubjectID, cleartext)
+	if err != nil {
+		return nil, err
+	}
+
+	return frame.NewKeyVersioned(r.current, ciphertext).Append(nil)
+}
+
+func (r *RotatingCrypter) Decrypt(ctx context.Context, dataSubjectID string, ciphertext []byte) ([]byte, error) {
+	f, err := frame.Parse(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := f.KeyVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	crypter, ok := r.crypters[version]
+	if !ok {
+		return nil, fmt.Errorf("no crypter for key version %d", version)
+	}
+
+	return crypter.Decrypt(ctx, dataSubjectID, f.Payload)
+}
+
+// Shred shreds the data subject id using the crypters of all key versions, which must implement Shredder.
+func (r *RotatingCrypter) Shred(ctx context.Context, dataSubjectID string) error {
+	return r.shred(func(shredder Shredder) error {
+		return shredder.Shred(ctx, dataSubjectID)
+	})
+}
+
+// ShredPrefix shreds the data subject ids starting with the prefix using the crypters of all key versions, which must
+// implement Shredder.
+func (r *RotatingCrypter) ShredPrefix(ctx context.Context, prefix string) error {
+	return r.shred(func(shredder Shredder) error {
+		return shredder.ShredPrefix(ctx, prefix)
+	})
+}
+
+// ShredSuffix shreds the data subject ids ending with the suffix using the crypters of all key versions, which must
+// implement SuffixShredder.
+func (r *RotatingCrypter) ShredSuffix(ctx context.Context, suffix string) error {
+	for version, crypter := range r.crypters {
+		shredder, ok := crypter.(SuffixShredder)
+		if !ok {
+			return fmt.Errorf("crypter for key version %d does not implement SuffixShredder", version)
+		}
+
+		if err := shredder.ShredSuffix(ctx, suffix); err != nil {
+			return fmt.Errorf("error shredding key version %d: %w", version, err)
+		}
+	}
+
+	return nil
+}
+
+// IsShredded reports whether the data subject id is shredded in the crypters of all key versions, which must implement
+// Shredder.
+func (r *RotatingCrypter) IsShredded(ctx context.Context, dataSubjectID string) (bool, error) {
+	shredded := true
+
+	err := r.shred(func(shredder Shredder) error {
+		s, err := shredder.IsShredded(ctx, dataSubjectID)
+		shredded = shredded && s
+		return err
+	})
+
+	return shredded, err
+}
+
+func (r *RotatingCrypter) shred(fn func(shredder Shredder) error) error {
+	for version, crypter := range r.crypters {
+		shredder, ok := crypter.(Shredder)
+		if !ok {
+			return fmt.Errorf("crypter for key version %d does not implement Shredder", version)
+		}
+
+		if err := fn(shredder); err != nil {
+			return fmt.Errorf("error shredding key version %d: %w", version, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package protoprivacy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Boostport/protoprivacy/frame"
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestRotatingCrypter(t *testing.T, current uint64, crypters map[uint64]Crypter) *RotatingCrypter {
+	r, err := NewRotatingCrypter(current, crypters)
+	if err != nil {
+		t.Fatalf("Error creating rotating crypter: %v", err)
+	}
+
+	return r
+}
+
+func keyVersion(t *testing.T, envelope proto.Message) uint64 {
+	info, err := frame.Inspect(envelope)
+	if err != nil {
+		t.Fatalf("Error inspecting envelope: %v", err)
+	}
+
+	if !info.HasKeyVersion {
+		t.Fatal("Encrypted data does not contain a key version")
+	}
+
+	return info.KeyVersion
+}
+
+func TestReencrypt(t *testing.T) {
+	ctx := context.Background()
+
+	msg := testprotos.TestMessage_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("test"),
+	}.Build()
+
+	v1 := &scopeRecordingCrypter{}
+	v2 := &scopeRecordingCrypter{}
+
+	encrypted, err := New(newTestRotatingCrypter(t, 1, map[uint64]Crypter{1: v1})).Encrypt(ctx, msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	if version := keyVersion(t, encrypted); version != 1 {
+		t.Errorf("Expected key version 1, got %d", version)
+	}
+
+	p := New(newTestRotatingCrypter(t, 2, map[uint64]Crypter{1: v1, 2: v2}))
+
+	reencrypted, err := p.Reencrypt(ctx, encrypted)
+	if err != nil {
+		t.Fatalf("Error re-encrypting message: %v", err)
+	}
+
+	if version := keyVersion(t, reencrypted); version != 2 {
+		t.Errorf("Expected key version 2, got %d", version)
+	}
+
+	if !proto.Equal(reencrypted.(*privacy.Envelope).GetMessage(), encrypted.(*privacy.Envelope).GetMessage()) {
+		t.Error("Expected redacted message to be unchanged")
+	}
+
+	if len(v2.encryptScopes) != 1 || v2.encryptScopes[0] != "123" {
+		t.Errorf("Expected re-encryption under data subject 123, got %v", v2.encryptScopes)
+	}
+
+	decrypted, err := p.Decrypt(ctx, reencrypted)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if !proto.Equal(decrypted, msg) {
+		t.Errorf("Decrypted message does not match original message, expected %v, got %v", msg, decrypted)
+	}
+}
+
+func TestReencryptShredded(t *testing.T) {
+	ctx := context.Background()
+
+	msg := testprotos.TestMessage_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("test"),
+	}.Build()
+
+	encrypted, err := New(newTestRotatingCrypter(t, 1, map[uint64]Crypter{1: fakeDeletedDataSubjectCrypter{}})).Encrypt(ctx, msg)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	v2 := &scopeRecordingCrypter{}
+	p := New(newTestRotatingCrypter(t, 2, map[uint64]Crypter{1: fakeDeletedDataSubjectCrypter{}, 2: v2}))
+
+	reencrypted, err := p.Reencrypt(ctx, encrypted)
+	if err != nil {
+		t.Fatalf("Error re-encrypting message: %v", err)
+	}
+
+	if !proto.Equal(reencrypted, encrypted) {
+		t.Error("Expected envelope of shredded data subject to be unchanged")
+	}
+
+	if len(v2.encryptScopes) != 0 {
+		t.Errorf("Expected shredded data subject not to be re-encrypted, got %v", v2.encryptScopes)
+	}
+}
+
+func TestReencryptNotEnvelope(t *testing.T) {
+	_, err := New(fakeCrypter{}).Reencrypt(context.Background(), testprotos.TestMessage_builder{Id: proto.String("123")}.Build())
+	if err == nil {
+		t.Error("Expected error re-encrypting message that is not an envelope")
+	}
+}
+
+func TestRotatingCrypter(t *testing.T) {
+	ctx := context.Background()
+
+	if _, err := NewRotatingCrypter(2, map[uint64]Crypter{1: fakeCrypter{}}); err == nil {
+		t.Error("Expected error creating rotating crypter without crypter for current key version")
+	}
+
+	ciphertext, err := newTestRotatingCrypter(t, 3, map[uint64]Crypter{3: fakeCrypter{}}).Encrypt(ctx, "123", []byte("test"))
+	if err != nil {
+		t.Fatalf("Error encrypting: %v", err)
+	}
+
+	if _, err := newTestRotatingCrypter(t, 1, map[uint64]Crypter{1: fakeCrypter{}}).Decrypt(ctx, "123", ciphertext); err == nil {
+		t.Error("Expected error decrypting ciphertext with unknown key version")
+	}
+
+	if _, err := newTestRotatingCrypter(t, 1, map[uint64]Crypter{1: fakeCrypter{}}).Decrypt(ctx, "123", nil); err == nil {
+		t.Error("Expected error decrypting ciphertext without key version")
+	}
+}
@@ -0,0 +1,167 @@
+package protoprivacy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RouterCrypter is a Crypter that dispatches to the crypter registered for the longest prefix matching the data subject
+// id, for example to keep the keys of "user:" and "employee:" data subjects in different key management services. The
+// data subject id is passed to the crypter unchanged, including its prefix.
+type RouterCrypter struct {
+	routes         map[string]Crypter
+	defaultCrypter Crypter
+}
+
+// NewRouterCrypter returns a RouterCrypter that dispatches to the crypters in routes by prefix. Data subject ids that
+// do not match any prefix are dispatched to defaultCrypter. If defaultCrypter is nil, an error is returned for them
+// instead.
+func NewRouterCrypter(routes map[string]Crypter, defaultCrypter Crypter) *RouterCrypter {
+	return &RouterCrypter{
+		routes:         routes,
+		defaultCrypter: defaultCrypter,
+	}
+}
+
+func (r *RouterCrypter) Encrypt(ctx context.Context, dataSubjectID string, cleartext []byte) ([]byte, error) {
+	crypter, err := r.route(dataSubjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypter.Encrypt(ctx, dataSubjectID, cleartext)
+}
+
+func (r *RouterCrypter) Decrypt(ctx context.Context, dataSubjectID string, ciphertext []byte) ([]byte, error) {
+	crypter, err := r.route(dataSubjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypter.Decrypt(ctx, dataSubjectID, ciphertext)
+}
+
+// Shred shreds the data subject id using the crypter it is dispatched to, which must implement Shredder.
+func (r *RouterCrypter) Shred(ctx context.Context, dataSubjectID string) error {
+	shredder, err := r.routeShredder(dataSubjectID)
+	if err != nil {
+		return err
+	}
+
+	return shredder.Shred(ctx, dataSubjectID)
+}
+
+// ShredPrefix shreds the data subject ids starting with the prefix using every crypter they can be dispatched to,
+// which must implement Shredder.
+func (r *RouterCrypter) ShredPrefix(ctx context.Context, prefix string) error {
+	var shredders []Shredder
+
+	// Data subject ids starting with the prefix are dispatched to the crypter of the prefix itself, unless they match
+	// a longer route
+	if crypter, err := r.route(prefix); err == nil {
+		shredder, ok := crypter.(Shredder)
+		if !ok {
+			return fmt.Errorf("crypter for prefix %q does not implement Shredder", prefix)
+		}
+
+		shredders = append(shredders, shredder)
+	}
+
+	for route, crypter := range r.routes {
+		// The crypter of the prefix itself was added above
+		if !strings.HasPrefix(route, prefix) || route == prefix {
+			continue
+		}
+
+		shredder, ok := crypter.(Shredder)
+		if !ok {
+			return fmt.Errorf("crypter for route %q does not implement Shredder", route)
+		}
+
+		shredders = append(shredders, shredder)
+	}
+
+	for _, shredder := range shredders {
+		if err := shredder.ShredPrefix(ctx, prefix); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ShredSuffix shreds the data subject ids ending with the suffix using every crypter, including the default crypter, as
+// data subject ids of any prefix can end with the suffix. The crypters must implement SuffixShredder.
+func (r *RouterCrypter) ShredSuffix(ctx context.Context, suffix string) error {
+	for route, crypter := range r.routes {
+		shredder, ok := crypter.(SuffixShredder)
+		if !ok {
+			return fmt.Errorf("crypter for route %q does not implement SuffixShredder", route)
+		}
+
+		if err := shredder.ShredSuffix(ctx, suffix); err != nil {
+			return err
+		}
+	}
+
+	if r.defaultCrypter == nil {
+		return nil
+	}
+
+	shredder, ok := r.defaultCrypter.(SuffixShredder)
+	if !ok {
+		return errors.New("default crypter does not implement SuffixShredder")
+	}
+
+	return shredder.ShredSuffix(ctx, suffix)
+}
+
+// IsShredded reports whether the data subject id is shredded using the crypter it is dispatched to, which must
+// implement Shredder.
+func (r *RouterCrypter) IsShredded(ctx context.Context, dataSubjectID string) (bool, error) {
+	shredder, err := r.routeShredder(dataSubjectID)
+	if err != nil {
+		return false, err
+	}
+
+	return shredder.IsShredded(ctx, dataSubjectID)
+}
+
+// route returns the crypter registered for the longest prefix matching the data subject id.
+func (r *RouterCrypter) route(dataSubjectID string) (Crypter, error) {
+	var match string
+	var crypter Crypter
+
+	for prefix, c := range r.routes {
+		if (crypter == nil || len(prefix) > len(match)) && strings.HasPrefix(dataSubjectID, prefix) {
+			match = prefix
+			crypter = c
+		}
+	}
+
+	if crypter != nil {
+		return crypter, nil
+	}
+
+	if r.defaultCrypter != nil {
+		return r.defaultCrypter, nil
+	}
+
+	return nil, errors.New("no crypter for data subject id prefix")
+}
+
+func (r *RouterCrypter) routeShredder(dataSubjectID string) (Shredder, error) {
+	crypter, err := r.route(dataSubjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	shredder, ok := crypter.(Shredder)
+	if !ok {
+		return nil, errors.New("crypter for data subject id does not implement Shredder")
+	}
+
+	return shredder, nil
+}
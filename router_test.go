@@ -0,0 +1,167 @@
+package protoprivacy
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRouterCrypter(t *testing.T) {
+	users := &scopeRecordingCrypter{}
+	admins := &scopeRecordingCrypter{}
+	fallback := &scopeRecordingCrypter{}
+
+	r := NewRouterCrypter(map[string]Crypter{
+		"user:":       users,
+		"user:admin:": admins,
+	}, fallback)
+
+	for _, tt := range []struct {
+		explanation   string
+		dataSubjectID string
+		expected      *scopeRecordingCrypter
+	}{
+		{
+			explanation:   "Matching prefix",
+			dataSubjectID: "user:123",
+			expected:      users,
+		},
+		{
+			explanation:   "Longest matching prefix",
+			dataSubjectID: "user:admin:123",
+			expected:      admins,
+		},
+		{
+			explanation:   "Default",
+			dataSubjectID: "employee:123",
+			expected:      fallback,
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			ciphertext, err := r.Encrypt(context.Background(), tt.dataSubjectID, []byte("test"))
+			if err != nil {
+				t.Fatalf("Error encrypting: %v", err)
+			}
+
+			if _, err := r.Decrypt(context.Background(), tt.dataSubjectID, ciphertext); err != nil {
+				t.Fatalf("Error decrypting: %v", err)
+			}
+
+			if len(tt.expected.encryptScopes) == 0 || tt.expected.encryptScopes[len(tt.expected.encryptScopes)-1] != tt.dataSubjectID {
+				t.Errorf("Expected encrypt to be dispatched with data subject id %s, got %v", tt.dataSubjectID, tt.expected.encryptScopes)
+			}
+
+			if len(tt.expected.decryptScopes) == 0 || tt.expected.decryptScopes[len(tt.expected.decryptScopes)-1] != tt.dataSubjectID {
+				t.Errorf("Expected decrypt to be dispatched with data subject id %s, got %v", tt.dataSubjectID, tt.expected.decryptScopes)
+			}
+		})
+	}
+}
+
+func TestRouterCrypterUnknownPrefix(t *testing.T) {
+	r := NewRouterCrypter(map[string]Crypter{"user:": fakeCrypter{}}, nil)
+
+	if _, err := r.Encrypt(context.Background(), "employee:123", []byte("test")); err == nil {
+		t.Error("Expected error encrypting for unknown prefix")
+	}
+
+	if _, err := r.Decrypt(context.Background(), "employee:123", []byte("dGVzdA==")); err == nil {
+		t.Error("Expected error decrypting for unknown prefix")
+	}
+}
+
+func TestRouterCrypterWithPrivacy(t *testing.T) {
+	users := &scopeRecordingCrypter{}
+	p := New(NewRouterCrypter(map[string]Crypter{"user:": users}, nil))
+
+	msg := testprotos.TestKeyBucketFromClock_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("test"),
+	}.Build()
+
+	// The data subject id of the message does not have a prefix
+	if _, err := p.Encrypt(context.Background(), msg); err == nil {
+		t.Error("Expected error encrypting message with unknown prefix")
+	}
+
+	prefixed := testprotos.TestKeyBucket_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("test"),
+	}.Build()
+
+	envelope, err := p.Encrypt(context.Background(), prefixed)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	decrypted, err := p.Decrypt(context.Background(), envelope)
+	if err != nil {
+		t.Fatalf("Error decrypting message: %v", err)
+	}
+
+	if !proto.Equal(decrypted, prefixed) {
+		t.Errorf("Decrypted message does not match original message, expected %v, got %v", prefixed, decrypted)
+	}
+}
+
+func TestRouterCrypterShred(t *testing.T) {
+	ctx := context.Background()
+	users := &fakeShredderCrypter{}
+	admins := &fakeShredderCrypter{}
+	fallback := &fakeShredderCrypter{}
+
+	r := NewRouterCrypter(map[string]Crypter{
+		"user:":       users,
+		"user:admin:": admins,
+	}, fallback)
+
+	if err := r.Shred(ctx, "user:admin:123"); err != nil {
+		t.Fatalf("Error shredding data subject: %v", err)
+	}
+
+	if !slices.Equal(admins.shredded, []string{"user:admin:123"}) || len(users.shredded) != 0 {
+		t.Errorf("Expected data subject to be shredded by the matching crypter only, got %v and %v", admins.shredded, users.shredded)
+	}
+
+	shredded, err := r.IsShredded(ctx, "user:admin:123")
+	if err != nil {
+		t.Fatalf("Error checking if data subject is shredded: %v", err)
+	}
+
+	if !shredded {
+		t.Error("Expected data subject to be shredded")
+	}
+
+	if err := r.ShredPrefix(ctx, "user:"); err != nil {
+		t.Fatalf("Error shredding prefix: %v", err)
+	}
+
+	if !slices.Equal(users.prefixes, []string{"user:"}) || !slices.Equal(admins.prefixes, []string{"user:"}) || len(fallback.prefixes) != 0 {
+		t.Errorf("Expected prefix to be shredded by all crypters it can be dispatched to, got %v, %v and %v", users.prefixes, admins.prefixes, fallback.prefixes)
+	}
+
+	if err := r.ShredPrefix(ctx, "employee:"); err != nil {
+		t.Fatalf("Error shredding prefix: %v", err)
+	}
+
+	if !slices.Equal(fallback.prefixes, []string{"employee:"}) {
+		t.Errorf("Expected unknown prefix to be shredded by the default crypter, got %v", fallback.prefixes)
+	}
+
+	if err := r.ShredSuffix(ctx, "@2025-02"); err != nil {
+		t.Fatalf("Error shredding suffix: %v", err)
+	}
+
+	for _, shredder := range []*fakeShredderCrypter{users, admins, fallback} {
+		if !slices.Equal(shredder.suffixes, []string{"@2025-02"}) {
+			t.Errorf("Expected suffix to be shredded by all crypters, got %v", shredder.suffixes)
+		}
+	}
+
+	if err := NewRouterCrypter(map[string]Crypter{"user:": fakeCrypter{}}, nil).Shred(ctx, "user:123"); err == nil {
+		t.Error("Expected error shredding with crypter that does not implement Shredder")
+	}
+}
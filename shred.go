@@ -0,0 +1,139 @@
+package protoprivacy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
+	"google.golang.org/protobuf/proto"
+)
+
+// Shredder is implemented by crypters that can delete the keys of data subjects, making the data encrypted for them
+// unreadable.
+type Shredder interface {
+	// Shred deletes the key of the data subject id.
+	Shred(ctx context.Context, dataSubjectID string) error
+
+	// ShredPrefix deletes the keys of all data subject ids starting with the prefix.
+	ShredPrefix(ctx context.Context, prefix string) error
+
+	// IsShredded reports whether the data subject id does not have a key, so data encrypted for it can no longer be
+	// decrypted.
+	IsShredded(ctx context.Context, dataSubjectID string) (bool, error)
+}
+
+// SuffixShredder is implemented by crypters that can delete the keys of all data subject ids ending with a suffix,
+// which Privacy.ShredBucket uses to shred all data subjects of a key bucket at once.
+type SuffixShredder interface {
+	// ShredSuffix deletes the keys of all data subject ids ending with the suffix.
+	ShredSuffix(ctx context.Context, suffix string) error
+}
+
+// Shred shreds the data subject of the message using the crypter, which must implement Shredder. The message can be an
+// envelope or an unencrypted message. The data subject id is built in the same way as when encrypting, including its
+// prefix. If the data subject id uses key buckets, the keys of all buckets are shredded. Stored envelopes are not
+// changed, so the blind indexes in their blind_indexes map remain and must be removed by the caller if required.
+func (p *Privacy) Shred(ctx context.Context, message proto.Message) error {
+	shredder, ok := p.crypter.(Shredder)
+	if !ok {
+		return errors.New("crypter does not implement Shredder")
+	}
+
+	m, _, err := p.unwrapEnvelope(message)
+	if err != nil {
+		return err
+	}
+
+	dataSubjectID, err := getDataSubjectID(m.ProtoReflect())
+	if err != nil {
+		return fmt.Errorf("error getting data subject id: %w", err)
+	}
+
+	if dataSubjectID == nil {
+		return errors.New("message does not contain a data subject id")
+	}
+
+	bucket, _, err := keyBucket(m.ProtoReflect(), p.clock())
+	if err != nil {
+		return fmt.Errorf("error getting key bucket: %w", err)
+	}
+
+	if err := shredder.Shred(ctx, keyScope(*dataSubjectID, "")); err != nil {
+		return fmt.Errorf("error shredding data subject: %w", err)
+	}
+
+	if bucket != "" {
+		if err := shredder.ShredPrefix(ctx, keyBucketPrefix(*dataSubjectID)); err != nil {
+			return fmt.Errorf("error shredding key buckets of data subject: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ShredBucket shreds the data of all data subjects in the key bucket, such as "2025-02" for KEY_BUCKET_MONTH or
+// "2025-Q1" for KEY_BUCKET_QUARTER, using the crypter, which must implement SuffixShredder. Data of data subject ids
+// without key buckets is not affected.
+func (p *Privacy) ShredBucket(ctx context.Context, bucket string) error {
+	shredder, ok := p.crypter.(SuffixShredder)
+	if !ok {
+		return errors.New("crypter does not implement SuffixShredder")
+	}
+
+	if bucket == "" || strings.Contains(bucket, keyBucketSeparator) {
+		return fmt.Errorf("invalid key bucket %q", bucket)
+	}
+
+	if err := shredder.ShredSuffix(ctx, keyBucketSuffix(bucket)); err != nil {
+		return fmt.Errorf("error shredding key bucket: %w", err)
+	}
+
+	return nil
+}
+
+// IsShredded reports whether the data subject of the message has been shredded using the crypter, which must implement
+// Shredder. For an envelope, the key scope it was encrypted under is checked, including its key bucket.
+func (p *Privacy) IsShredded(ctx context.Context, message proto.Message) (bool, error) {
+	shredder, ok := p.crypter.(Shredder)
+	if !ok {
+		return false, errors.New("crypter does not implement Shredder")
+	}
+
+	m, envelope, err := p.unwrapEnvelope(message)
+	if err != nil {
+		return false, err
+	}
+
+	if envelope == nil {
+		envelope = &privacy.Envelope{}
+	}
+
+	scope, err := p.envelopeKeyScope(envelope, m.ProtoReflect())
+	if err != nil {
+		return false, err
+	}
+
+	return shredder.IsShredded(ctx, scope)
+}
+
+// unwrapEnvelope returns the redacted message of an envelope and the envelope, or the message itself if it is not an
+// envelope. The message must be valid.
+func (p *Privacy) unwrapEnvelope(message proto.Message) (proto.Message, *privacy.Envelope, error) {
+	envelope, ok := message.(*privacy.Envelope)
+	if ok {
+		var err error
+
+		message, err = envelope.GetMessage().UnmarshalNew()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error unmarshaling message: %w", err)
+		}
+	}
+
+	if _, err := p.loadMessage(message); err != nil {
+		return nil, nil, err
+	}
+
+	return message, envelope, nil
+}
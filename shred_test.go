@@ -0,0 +1,332 @@
+package protoprivacy
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeShredderCrypter is a fakeCrypter that implements Shredder by keeping track of shredded key scopes.
+type fakeShredderCrypter struct {
+	fakeCrypter
+	mu       sync.Mutex
+	shredded []string
+	prefixes []string
+	suffixes []string
+}
+
+func (c *fakeShredderCrypter) Decrypt(ctx context.Context, dataSubjectID string, ciphertext []byte) ([]byte, error) {
+	if shredded, _ := c.IsShredded(ctx, dataSubjectID); shredded {
+		return nil, nil
+	}
+
+	return c.fakeCrypter.Decrypt(ctx, dataSubjectID, ciphertext)
+}
+
+func (c *fakeShredderCrypter) Shred(_ context.Context, dataSubjectID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.shredded = append(c.shredded, dataSubjectID)
+	return nil
+}
+
+func (c *fakeShredderCrypter) ShredPrefix(_ context.Context, prefix string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.prefixes = append(c.prefixes, prefix)
+	return nil
+}
+
+func (c *fakeShredderCrypter) ShredSuffix(_ context.Context, suffix string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.suffixes = append(c.suffixes, suffix)
+	return nil
+}
+
+func (c *fakeShredderCrypter) IsShredded(_ context.Context, dataSubjectID string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if slices.Contains(c.shredded, dataSubjectID) {
+		return true, nil
+	}
+
+	if slices.ContainsFunc(c.suffixes, func(suffix string) bool {
+		return strings.HasSuffix(dataSubjectID, suffix)
+	}) {
+		return true, nil
+	}
+
+	return slices.ContainsFunc(c.prefixes, func(prefix string) bool {
+		return strings.HasPrefix(dataSubjectID, prefix)
+	}), nil
+}
+
+func TestShred(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 2, 14, 10, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		explanation      string
+		proto            proto.Message
+		expectedShredded []string
+		expectedPrefixes []string
+	}{
+		{
+			explanation: "Data subject id",
+			proto: testprotos.TestMessage_builder{
+				Id:    proto.String("123"),
+				Data1: proto.String("test"),
+			}.Build(),
+			expectedShredded: []string{"123"},
+		},
+		{
+			explanation: "Data subject id with prefix and key buckets",
+			proto: testprotos.TestKeyBucket_builder{
+				Id:        proto.String("123"),
+				CreatedAt: timestamppb.New(createdAt),
+				Data1:     proto.String("test"),
+			}.Build(),
+			expectedShredded: []string{"user:123"},
+			expectedPrefixes: []string{"user:123@"},
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			crypter := &fakeShredderCrypter{}
+			p := New(crypter)
+
+			envelope, err := p.Encrypt(ctx, tt.proto)
+			if err != nil {
+				t.Fatalf("Error encrypting message: %v", err)
+			}
+
+			shredded, err := p.IsShredded(ctx, envelope)
+			if err != nil {
+				t.Fatalf("Error checking if data subject is shredded: %v", err)
+			}
+
+			if shredded {
+				t.Error("Expected data subject not to be shredded before shredding")
+			}
+
+			if err := p.Shred(ctx, envelope); err != nil {
+				t.Fatalf("Error shredding data subject: %v", err)
+			}
+
+			if !slices.Equal(crypter.shredded, tt.expectedShredded) {
+				t.Errorf("Expected shredded data subject ids %v, got %v", tt.expectedShredded, crypter.shredded)
+			}
+
+			if !slices.Equal(crypter.prefixes, tt.expectedPrefixes) {
+				t.Errorf("Expected shredded prefixes %v, got %v", tt.expectedPrefixes, crypter.prefixes)
+			}
+
+			shredded, err = p.IsShredded(ctx, envelope)
+			if err != nil {
+				t.Fatalf("Error checking if data subject is shredded: %v", err)
+			}
+
+			if !shredded {
+				t.Error("Expected data subject to be shredded")
+			}
+
+			// Shredding using the unencrypted message shreds the same data subject
+			if err := p.Shred(ctx, tt.proto); err != nil {
+				t.Fatalf("Error shredding data subject: %v", err)
+			}
+
+			if crypter.shredded[len(crypter.shredded)-1] != tt.expectedShredded[0] {
+				t.Errorf("Expected shredded data subject id %s, got %s", tt.expectedShredded[0], crypter.shredded[len(crypter.shredded)-1])
+			}
+		})
+	}
+}
+
+func TestShredKeyBucketsOfOtherDataSubjects(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 2, 14, 10, 0, 0, 0, time.UTC)
+
+	crypter := &fakeShredderCrypter{}
+	p := New(crypter)
+
+	john := testprotos.TestKeyBucket_builder{
+		Id:        proto.String("john"),
+		CreatedAt: timestamppb.New(createdAt),
+		Data1:     proto.String("test"),
+	}.Build()
+
+	johnEmail := testprotos.TestKeyBucket_builder{
+		Id:        proto.String("john@example.com"),
+		CreatedAt: timestamppb.New(createdAt),
+		Data1:     proto.String("test"),
+	}.Build()
+
+	envelope, err := p.Encrypt(ctx, johnEmail)
+	if err != nil {
+		t.Fatalf("Error encrypting message: %v", err)
+	}
+
+	if err := p.Shred(ctx, john); err != nil {
+		t.Fatalf("Error shredding data subject: %v", err)
+	}
+
+	shredded, err := p.IsShredded(ctx, envelope)
+	if err != nil {
+		t.Fatalf("Error checking if data subject is shredded: %v", err)
+	}
+
+	if shredded {
+		t.Error("Expected data subject with an id starting with the shredded data subject id and the key bucket separator not to be shredded")
+	}
+}
+
+func TestShredBucket(t *testing.T) {
+	ctx := context.Background()
+
+	crypter := &fakeShredderCrypter{}
+	p := New(crypter)
+
+	for _, tt := range []struct {
+		explanation string
+		proto       proto.Message
+		shredded    bool
+	}{
+		{
+			explanation: "Data subject in key bucket",
+			proto: testprotos.TestKeyBucket_builder{
+				Id:        proto.String("123"),
+				CreatedAt: timestamppb.New(time.Date(2025, 2, 14, 10, 0, 0, 0, time.UTC)),
+				Data1:     proto.String("test"),
+			}.Build(),
+			shredded: true,
+		},
+		{
+			explanation: "Other data subject in key bucket",
+			proto: testprotos.TestKeyBucket_builder{
+				Id:        proto.String("john@example.com"),
+				CreatedAt: timestamppb.New(time.Date(2025, 2, 28, 10, 0, 0, 0, time.UTC)),
+				Data1:     proto.String("test"),
+			}.Build(),
+			shredded: true,
+		},
+		{
+			explanation: "Data subject in other key bucket",
+			proto: testprotos.TestKeyBucket_builder{
+				Id:        proto.String("123"),
+				CreatedAt: timestamppb.New(time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)),
+				Data1:     proto.String("test"),
+			}.Build(),
+		},
+		{
+			explanation: "Data subject id without key bucket ending with key bucket",
+			proto: testprotos.TestMessage_builder{
+				Id:    proto.String("john@2025-02"),
+				Data1: proto.String("test"),
+			}.Build(),
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			envelope, err := p.Encrypt(ctx, tt.proto)
+			if err != nil {
+				t.Fatalf("Error encrypting message: %v", err)
+			}
+
+			if err := p.ShredBucket(ctx, "2025-02"); err != nil {
+				t.Fatalf("Error shredding key bucket: %v", err)
+			}
+
+			shredded, err := p.IsShredded(ctx, envelope)
+			if err != nil {
+				t.Fatalf("Error checking if data subject is shredded: %v", err)
+			}
+
+			if shredded != tt.shredded {
+				t.Errorf("Expected shredded to be %t, got %t", tt.shredded, shredded)
+			}
+		})
+	}
+}
+
+func TestShredBucketInvalid(t *testing.T) {
+	p := New(&fakeShredderCrypter{})
+
+	for _, bucket := range []string{"", "2025@02"} {
+		if err := p.ShredBucket(context.Background(), bucket); err == nil {
+			t.Errorf("Expected error shredding invalid key bucket %q", bucket)
+		}
+	}
+
+	if err := New(fakeCrypter{}).ShredBucket(context.Background(), "2025-02"); err == nil {
+		t.Error("Expected error shredding key bucket with crypter that does not implement SuffixShredder")
+	}
+}
+
+func TestShredWithoutShredder(t *testing.T) {
+	p := New(fakeCrypter{})
+
+	msg := testprotos.TestMessage_builder{
+		Id: proto.String("123"),
+	}.Build()
+
+	if err := p.Shred(context.Background(), msg); err == nil {
+		t.Error("Expected error shredding with crypter that does not implement Shredder")
+	}
+
+	if _, err := p.IsShredded(context.Background(), msg); err == nil {
+		t.Error("Expected error checking if shredded with crypter that does not implement Shredder")
+	}
+}
+
+func TestShredWithoutDataSubjectID(t *testing.T) {
+	if err := New(&fakeShredderCrypter{}).Shred(context.Background(), &testprotos.TestMessage{}); err == nil {
+		t.Error("Expected error shredding message without data subject id")
+	}
+}
+
+func TestRotatingCrypterShred(t *testing.T) {
+	ctx := context.Background()
+	v1 := &fakeShredderCrypter{}
+	v2 := &fakeShredderCrypter{}
+
+	r := newTestRotatingCrypter(t, 2, map[uint64]Crypter{1: v1, 2: v2})
+
+	if err := r.Shred(ctx, "123"); err != nil {
+		t.Fatalf("Error shredding data subject: %v", err)
+	}
+
+	if !slices.Equal(v1.shredded, []string{"123"}) || !slices.Equal(v2.shredded, []string{"123"}) {
+		t.Errorf("Expected data subject to be shredded in all key versions, got %v and %v", v1.shredded, v2.shredded)
+	}
+
+	shredded, err := r.IsShredded(ctx, "123")
+	if err != nil {
+		t.Fatalf("Error checking if data subject is shredded: %v", err)
+	}
+
+	if !shredded {
+		t.Error("Expected data subject to be shredded")
+	}
+
+	if err := r.ShredSuffix(ctx, "@2025-02"); err != nil {
+		t.Fatalf("Error shredding suffix: %v", err)
+	}
+
+	if !slices.Equal(v1.suffixes, []string{"@2025-02"}) || !slices.Equal(v2.suffixes, []string{"@2025-02"}) {
+		t.Errorf("Expected suffix to be shredded in all key versions, got %v and %v", v1.suffixes, v2.suffixes)
+	}
+
+	if err := newTestRotatingCrypter(t, 1, map[uint64]Crypter{1: fakeCrypter{}}).Shred(ctx, "123"); err == nil {
+		t.Error("Expected error shredding with crypter that does not implement Shredder")
+	}
+}
@@ -0,0 +1,137 @@
+package protoprivacy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewSlogHandler returns a slog.Handler that redacts protobuf messages in log attributes before passing records to
+// handler. Messages are rendered as JSON with their personal data fields cleared.
+func NewSlogHandler(handler slog.Handler) slog.Handler {
+	return defaultPrivacy.SlogHandler(handler)
+}
+
+// SlogHandler returns a slog.Handler that redacts protobuf messages in log attributes, including attributes nested in
+// groups, before passing records to handler. Messages are rendered as JSON and redacted using Redact.
+func (p *Privacy) SlogHandler(handler slog.Handler) slog.Handler {
+	return &slogHandler{
+		handler: handler,
+		privacy: p,
+	}
+}
+
+// LogValuer returns a slog.LogValuer that renders the message as JSON with its personal data fields redacted.
+func (p *Privacy) LogValuer(message proto.Message) slog.LogValuer {
+	return logValuer{
+		privacy: p,
+		message: message,
+	}
+}
+
+type slogHandler struct {
+	handler slog.Handler
+	privacy *Privacy
+}
+
+func (h *slogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+func (h *slogHandler) Handle(ctx context.Context, record slog.Record) error {
+	redacted := slog.NewRecord(record.Time, record.Level, record.Message, record.PC)
+
+	record.Attrs(func(attr slog.Attr) bool {
+		redacted.AddAttrs(h.privacy.redactAttr(ctx, attr))
+		return true
+	})
+
+	return h.handler.Handle(ctx, redacted)
+}
+
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &slogHandler{
+		handler: h.handler.WithAttrs(h.privacy.redactAttrs(context.Background(), attrs)),
+		privacy: h.privacy,
+	}
+}
+
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	return &slogHandler{
+		handler: h.handler.WithGroup(name),
+		privacy: h.privacy,
+	}
+}
+
+type logValuer struct {
+	privacy *Privacy
+	message proto.Message
+}
+
+func (l logValuer) LogValue() slog.Value {
+	return l.privacy.redactedLogValue(context.Background(), l.message)
+}
+
+// redactedJSON is a JSON encoded message that is written as is by both the JSON and text handlers.
+type redactedJSON []byte
+
+func (r redactedJSON) MarshalJSON() ([]byte, error) {
+	return r, nil
+}
+
+func (r redactedJSON) MarshalText() ([]byte, error) {
+	return r, nil
+}
+
+func (r redactedJSON) String() string {
+	return string(r)
+}
+
+func (p *Privacy) redactAttrs(ctx context.Context, attrs []slog.Attr) []slog.Attr {
+	redacted := make([]slog.Attr, len(attrs))
+
+	for i, attr := range attrs {
+		redacted[i] = p.redactAttr(ctx, attr)
+	}
+
+	return redacted
+}
+
+func (p *Privacy) redactAttr(ctx context.Context, attr slog.Attr) slog.Attr {
+	value := attr.Value.Resolve()
+
+	switch value.Kind() {
+	case slog.KindGroup:
+		return slog.Attr{Key: attr.Key, Value: slog.GroupValue(p.redactAttrs(ctx, value.Group())...)}
+	case slog.KindAny:
+		if message, ok := value.Any().(proto.Message); ok {
+			return slog.Attr{Key: attr.Key, Value: p.redactedLogValue(ctx, message)}
+		}
+	}
+
+	return slog.Attr{Key: attr.Key, Value: value}
+}
+
+func (p *Privacy) redactedLogValue(ctx context.Context, message proto.Message) slog.Value {
+	redacted, err := p.redact(ctx, message)
+	if err != nil {
+		return slog.StringValue(fmt.Sprintf("!ERROR:error redacting message: %s", err))
+	}
+
+	marshaled, err := protojson.Marshal(redacted)
+	if err != nil {
+		return slog.StringValue(fmt.Sprintf("!ERROR:error marshaling message: %s", err))
+	}
+
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, marshaled); err != nil {
+		return slog.StringValue(fmt.Sprintf("!ERROR:error compacting message: %s", err))
+	}
+
+	return slog.AnyValue(redactedJSON(compacted.Bytes()))
+}
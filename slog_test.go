@@ -0,0 +1,109 @@
+package protoprivacy
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	testprotos "github.com/Boostport/protoprivacy/internal/generated/boostport/privacy/testing"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSlogHandler(t *testing.T) {
+	msg := testprotos.TestMessage_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("secret1"),
+		Data2: testprotos.TestNested1_builder{
+			Data1: proto.String("secret2"),
+			Data4: proto.String("public"),
+		}.Build(),
+	}.Build()
+
+	p := New(nil)
+
+	for _, tt := range []struct {
+		explanation string
+		log         func(logger *slog.Logger)
+	}{
+		{
+			explanation: "Attribute",
+			log: func(logger *slog.Logger) {
+				logger.Info("event", "msg", msg)
+			},
+		},
+		{
+			explanation: "Group",
+			log: func(logger *slog.Logger) {
+				logger.Info("event", slog.Group("group", slog.Group("nested", "msg", msg)))
+			},
+		},
+		{
+			explanation: "With attributes",
+			log: func(logger *slog.Logger) {
+				logger.With("msg", msg).WithGroup("group").Info("event")
+			},
+		},
+		{
+			explanation: "Log valuer",
+			log: func(logger *slog.Logger) {
+				logger.Info("event", "msg", p.LogValuer(msg))
+			},
+		},
+	} {
+		t.Run(tt.explanation, func(t *testing.T) {
+			var buf bytes.Buffer
+			tt.log(slog.New(p.SlogHandler(slog.NewJSONHandler(&buf, nil))))
+
+			output := buf.String()
+
+			if strings.Contains(output, "secret") {
+				t.Errorf("Log output contains personal data: %s", output)
+			}
+
+			if !strings.Contains(output, `"id":"123"`) || !strings.Contains(output, `"data4":"public"`) {
+				t.Errorf("Log output does not contain non-personal data: %s", output)
+			}
+
+			if !json.Valid(buf.Bytes()) {
+				t.Errorf("Log output is not valid JSON: %s", output)
+			}
+		})
+	}
+}
+
+func TestSlogHandlerHashMask(t *testing.T) {
+	msg := testprotos.TestMask_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("4111111111111111"),
+		Data5: proto.String("secret"),
+	}.Build()
+
+	var buf bytes.Buffer
+	slog.New(NewSlogHandler(slog.NewJSONHandler(&buf, nil))).Info("event", "msg", msg)
+
+	output := buf.String()
+
+	if strings.Contains(output, "secret") || strings.Contains(output, "!ERROR") {
+		t.Errorf("Expected hash masked field to be redacted without error: %s", output)
+	}
+
+	if !strings.Contains(output, `"id":"123"`) || !strings.Contains(output, `"data1":"************1111"`) {
+		t.Errorf("Log output does not contain non-personal data and masked values: %s", output)
+	}
+}
+
+func TestLogValuerWithoutHandler(t *testing.T) {
+	msg := testprotos.TestMessage_builder{
+		Id:    proto.String("123"),
+		Data1: proto.String("secret"),
+	}.Build()
+
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("event", "msg", New(nil).LogValuer(msg))
+
+	if strings.Contains(buf.String(), "secret") {
+		t.Errorf("Log output contains personal data: %s", buf.String())
+	}
+}
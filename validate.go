@@ -1,15 +1,19 @@
 package protoprivacy
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"unicode/utf8"
 
 	"github.com/Boostport/protoprivacy/internal/generated/boostport/privacy"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-func validateMessage(message proto.Message) (bool, error) {
+// validateMessage validates the privacy field options of the message. It reports whether the message has privacy fields
+// and returns the compiled conditions of its personal data fields.
+func validateMessage(message proto.Message) (bool, conditions, error) {
 
 	var errs error
 
@@ -61,11 +65,62 @@ func validateMessage(message proto.Message) (bool, error) {
 			errs = errors.Join(errs, fmt.Errorf("field %s in message %s has a fallback value with type %s but the field has type %s in %s", f.FullName(), reflect.FullName(), fieldFallbackKind(f), f.Kind(), reflect.ParentFile().Path()))
 		}
 
+		// Mask (if set) can only be used on singular string fields
+		if fieldHasPersonalData(f) && fieldHasMask(f) && (f.Kind() != protoreflect.StringKind || f.IsList()) {
+			errs = errors.Join(errs, fmt.Errorf("field %s in message %s has a mask but is not a singular string field in %s", f.FullName(), reflect.FullName(), reflect.ParentFile().Path()))
+		}
+
+		// Mask character (if set) must be a single character
+		if fieldHasPersonalData(f) && fieldHasMaskCharacter(f) && utf8.RuneCountInString(fieldMaskCharacter(f)) != 1 {
+			errs = errors.Join(errs, fmt.Errorf("field %s in message %s has a mask character %q but it must be a single character in %s", f.FullName(), reflect.FullName(), fieldMaskCharacter(f), reflect.ParentFile().Path()))
+		}
+
+		// Pseudonymize (if set) can only be used on singular string fields
+		if fieldHasPersonalData(f) && fieldHasPseudonymize(f) && (f.Kind() != protoreflect.StringKind || f.IsList()) {
+			errs = errors.Join(errs, fmt.Errorf("field %s in message %s is pseudonymized but is not a singular string field in %s", f.FullName(), reflect.FullName(), reflect.ParentFile().Path()))
+		}
+
+		// A field cannot be both masked and pseudonymized
+		if fieldHasPersonalData(f) && fieldHasMask(f) && fieldHasPseudonymize(f) {
+			errs = errors.Join(errs, fmt.Errorf("field %s in message %s has both a mask and is pseudonymized in %s", f.FullName(), reflect.FullName(), reflect.ParentFile().Path()))
+		}
+
+		// A field can have either a category or a custom category
+		if fieldHasPersonalData(f) && fieldHasCategoryAndCustomCategory(f) {
+			errs = errors.Join(errs, fmt.Errorf("field %s in message %s has both a category and a custom category in %s", f.FullName(), reflect.FullName(), reflect.ParentFile().Path()))
+		}
+
+		// Key bucket timestamp field (if set) must be a timestamp field in the same message as the data subject id
+		if fieldHasDataSubjectID(f) {
+			errs = errors.Join(errs, validateKeyBucket(f, proto.GetExtension(f.Options(), privacy.E_Field).(*privacy.PrivacyFieldOptions).GetDataSubjectId()))
+		}
+
+		// Blind index (if set) can only be used on singular string fields and must have a valid target field and length
+		if fieldHasPersonalData(f) && fieldHasBlindIndex(f) {
+			errs = errors.Join(errs, validateBlindIndex(f))
+		}
+
 		return false
 	})
 
 	if numDataSubjectIDs == 0 && numPersonalData == 0 {
-		return false, errs
+		return false, nil, errs
+	}
+
+	// Retention periods (if set) must be positive and the message must have a valid retention timestamp field
+	if err := validateRetention(reflect); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	// Blind indexes stored in the envelope must not be in repeated or map fields
+	if err := validateBlindIndexPaths(reflect, false); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	// Conditions (if set) must compile, evaluate to a bool and not reference fields that are changed when redacting
+	compiled, err := compileConditions(reflect)
+	if err != nil {
+		errs = errors.Join(errs, err)
 	}
 
 	if numDataSubjectIDs > 1 {
@@ -84,7 +139,7 @@ func validateMessage(message proto.Message) (bool, error) {
 		errs = errors.Join(errs, fmt.Errorf("message %s must have at least 1 field with the personal_data field option in %s", reflect.FullName(), reflect.ParentFile().Path()))
 	}
 
-	return true, errs
+	return true, compiled, errs
 }
 
 // walkFields walks all fields in a message and calls the provided function for each field. If the function returns true, the walk is stopped.
@@ -175,6 +230,146 @@ func fieldHasFallback(f protoreflect.FieldDescriptor) bool {
 	return personalData.HasFallback()
 }
 
+func fieldHasMask(f protoreflect.FieldDescriptor) bool {
+	options := f.Options()
+
+	if options == nil {
+		return false
+	}
+
+	privacyField := proto.GetExtension(options, privacy.E_Field).(*privacy.PrivacyFieldOptions)
+	if privacyField == nil {
+		return false
+	}
+
+	return privacyField.GetPersonalData().GetMask().HasStrategy()
+}
+
+func fieldHasMaskCharacter(f protoreflect.FieldDescriptor) bool {
+	options := f.Options()
+
+	if options == nil {
+		return false
+	}
+
+	privacyField := proto.GetExtension(options, privacy.E_Field).(*privacy.PrivacyFieldOptions)
+	if privacyField == nil {
+		return false
+	}
+
+	return privacyField.GetPersonalData().GetMask().HasCharacter()
+}
+
+func fieldMaskCharacter(f protoreflect.FieldDescriptor) string {
+	return proto.GetExtension(f.Options(), privacy.E_Field).(*privacy.PrivacyFieldOptions).GetPersonalData().GetMask().GetCharacter()
+}
+
+func fieldHasPseudonymize(f protoreflect.FieldDescriptor) bool {
+	options := f.Options()
+
+	if options == nil {
+		return false
+	}
+
+	privacyField := proto.GetExtension(options, privacy.E_Field).(*privacy.PrivacyFieldOptions)
+	if privacyField == nil {
+		return false
+	}
+
+	return privacyField.GetPersonalData().HasPseudonymize()
+}
+
+func fieldHasBlindIndex(f protoreflect.FieldDescriptor) bool {
+	options := f.Options()
+
+	if options == nil {
+		return false
+	}
+
+	privacyField := proto.GetExtension(options, privacy.E_Field).(*privacy.PrivacyFieldOptions)
+	if privacyField == nil {
+		return false
+	}
+
+	return privacyField.GetPersonalData().HasBlindIndex()
+}
+
+func fieldHasCategoryAndCustomCategory(f protoreflect.FieldDescriptor) bool {
+	options := f.Options()
+
+	if options == nil {
+		return false
+	}
+
+	privacyField := proto.GetExtension(options, privacy.E_Field).(*privacy.PrivacyFieldOptions)
+	if privacyField == nil {
+		return false
+	}
+
+	personalData := privacyField.GetPersonalData()
+
+	return personalData.HasCategory() && personalData.HasCustomCategory()
+}
+
+func validateBlindIndex(f protoreflect.FieldDescriptor) error {
+	var errs error
+
+	blindIndex := proto.GetExtension(f.Options(), privacy.E_Field).(*privacy.PrivacyFieldOptions).GetPersonalData().GetBlindIndex()
+	path := f.ParentFile().Path()
+
+	if f.Kind() != protoreflect.StringKind || f.IsList() {
+		errs = errors.Join(errs, fmt.Errorf("field %s has a blind index but is not a singular string field in %s", f.FullName(), path))
+	}
+
+	if blindIndex.HasLength() && (blindIndex.GetLength() < 1 || blindIndex.GetLength() > sha256.Size) {
+		errs = errors.Join(errs, fmt.Errorf("field %s has a blind index length of %d but it must be between 1 and %d in %s", f.FullName(), blindIndex.GetLength(), sha256.Size, path))
+	}
+
+	if target := blindIndex.GetField(); target != "" {
+		targetField := f.ContainingMessage().Fields().ByName(protoreflect.Name(target))
+
+		switch {
+		case targetField == nil:
+			errs = errors.Join(errs, fmt.Errorf("field %s has a blind index with target field %s that does not exist in %s", f.FullName(), target, path))
+		case targetField.Kind() != protoreflect.StringKind || targetField.IsList():
+			errs = errors.Join(errs, fmt.Errorf("field %s has a blind index with target field %s that is not a singular string field in %s", f.FullName(), targetField.FullName(), path))
+		case fieldHasPersonalData(targetField) || fieldHasDataSubjectID(targetField):
+			errs = errors.Join(errs, fmt.Errorf("field %s has a blind index with target field %s that has privacy field options in %s", f.FullName(), targetField.FullName(), path))
+		}
+	}
+
+	return errs
+}
+
+// validateBlindIndexPaths checks that fields with a blind index without a target field are not in a repeated or map
+// field of the message, as the envelope stores a single blind index per field, so only the index of the last element
+// could be searched. Blind indexes with a target field are written next to each element and are allowed.
+func validateBlindIndexPaths(msg protoreflect.MessageDescriptor, repeated bool) error {
+	var errs error
+
+	fields := msg.Fields()
+
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		inRepeated := repeated || field.IsList() || field.IsMap()
+
+		if field.Kind() == protoreflect.MessageKind {
+			errs = errors.Join(errs, validateBlindIndexPaths(field.Message(), inRepeated))
+			continue
+		}
+
+		if !repeated || !fieldHasPersonalData(field) || !fieldHasBlindIndex(field) {
+			continue
+		}
+
+		if proto.GetExtension(field.Options(), privacy.E_Field).(*privacy.PrivacyFieldOptions).GetPersonalData().GetBlindIndex().GetField() == "" {
+			errs = errors.Join(errs, fmt.Errorf("field %s has a blind index without a target field but is in a repeated or map field in %s", field.FullName(), field.ParentFile().Path()))
+		}
+	}
+
+	return errs
+}
+
 func fieldFallbackKind(f protoreflect.FieldDescriptor) protoreflect.Kind {
 	options := f.Options()
 
@@ -64,9 +64,109 @@ func TestInvalidMessages(t *testing.T) {
 			explanation: "Fallback type must match field type",
 			message:     &testprotos.InvalidFallbackTypes{},
 		},
+		{
+			explanation: "Mask must be on a string field",
+			message:     &testprotos.InvalidMaskNonString{},
+		},
+		{
+			explanation: "Mask must not be on a repeated field",
+			message:     &testprotos.InvalidMaskRepeated{},
+		},
+		{
+			explanation: "Mask character must not be empty",
+			message:     &testprotos.InvalidMaskCharacterEmpty{},
+		},
+		{
+			explanation: "Mask character must be a single character",
+			message:     &testprotos.InvalidMaskCharacterMultiple{},
+		},
+		{
+			explanation: "Pseudonymize must be on a string field",
+			message:     &testprotos.InvalidPseudonymizeNonString{},
+		},
+		{
+			explanation: "Field must not be both masked and pseudonymized",
+			message:     &testprotos.InvalidPseudonymizeWithMask{},
+		},
+		{
+			explanation: "Blind index target field must exist",
+			message:     &testprotos.InvalidBlindIndexMissingTarget{},
+		},
+		{
+			explanation: "Blind index target field must not be personal data",
+			message:     &testprotos.InvalidBlindIndexPersonalDataTarget{},
+		},
+		{
+			explanation: "Blind index length must not exceed hash size",
+			message:     &testprotos.InvalidBlindIndexLength{},
+		},
+		{
+			explanation: "Blind index without target field must not be in a repeated field",
+			message:     &testprotos.InvalidBlindIndexRepeated{},
+		},
+		{
+			explanation: "Field must not have both a category and a custom category",
+			message:     &testprotos.InvalidCategoryAndCustomCategory{},
+		},
+		{
+			explanation: "Condition must be valid CEL",
+			message:     &testprotos.InvalidConditionSyntax{},
+		},
+		{
+			explanation: "Condition must only reference fields in the message",
+			message:     &testprotos.InvalidConditionUnknownField{},
+		},
+		{
+			explanation: "Condition must evaluate to a bool",
+			message:     &testprotos.InvalidConditionNotBool{},
+		},
+		{
+			explanation: "Condition must not reference personal data fields",
+			message:     &testprotos.InvalidConditionPersonalDataField{},
+		},
+		{
+			explanation: "Condition must not reference personal data fields of nested messages",
+			message:     &testprotos.InvalidConditionNestedPersonalDataField{},
+		},
+		{
+			explanation: "Condition must not reference blind index target fields",
+			message:     &testprotos.InvalidConditionBlindIndexTarget{},
+		},
+		{
+			explanation: "Retention requires a retention timestamp field",
+			message:     &testprotos.InvalidRetentionWithoutTimestampField{},
+		},
+		{
+			explanation: "Retention timestamp field must be a timestamp",
+			message:     &testprotos.InvalidRetentionTimestampFieldType{},
+		},
+		{
+			explanation: "Retention timestamp field must not be personal data",
+			message:     &testprotos.InvalidRetentionTimestampFieldPersonalData{},
+		},
+		{
+			explanation: "Retention must be positive",
+			message:     &testprotos.InvalidRetentionNotPositive{},
+		},
+		{
+			explanation: "Key bucket timestamp field requires a key bucket",
+			message:     &testprotos.InvalidKeyBucketTimestampFieldWithoutKeyBucket{},
+		},
+		{
+			explanation: "Key bucket timestamp field must exist",
+			message:     &testprotos.InvalidKeyBucketMissingTimestampField{},
+		},
+		{
+			explanation: "Key bucket timestamp field must be a timestamp",
+			message:     &testprotos.InvalidKeyBucketTimestampFieldType{},
+		},
+		{
+			explanation: "Key bucket timestamp field must not be personal data",
+			message:     &testprotos.InvalidKeyBucketTimestampFieldPersonalData{},
+		},
 	} {
 		t.Run(tt.explanation, func(t *testing.T) {
-			_, err := validateMessage(tt.message)
+			_, _, err := validateMessage(tt.message)
 
 			if err == nil {
 				t.Error("Expected error, but invalid message passed validation")
@@ -152,9 +252,33 @@ func TestValidMessages(t *testing.T) {
 			explanation: "Valid fallback types",
 			message:     &testprotos.ValidFallbackTypes{},
 		},
+		{
+			explanation: "Valid mask",
+			message:     &testprotos.ValidMask{},
+		},
+		{
+			explanation: "Valid pseudonymize",
+			message:     &testprotos.ValidPseudonymize{},
+		},
+		{
+			explanation: "Valid blind index",
+			message:     &testprotos.ValidBlindIndex{},
+		},
+		{
+			explanation: "Valid condition",
+			message:     &testprotos.ValidCondition{},
+		},
+		{
+			explanation: "Valid retention",
+			message:     &testprotos.ValidRetention{},
+		},
+		{
+			explanation: "Valid key bucket",
+			message:     &testprotos.ValidKeyBucket{},
+		},
 	} {
 		t.Run(tt.explanation, func(t *testing.T) {
-			_, err := validateMessage(tt.message)
+			_, _, err := validateMessage(tt.message)
 
 			if err != nil {
 				t.Errorf("Unexpected validation failure: %s", err)